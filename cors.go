@@ -0,0 +1,132 @@
+package gohttputil
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/rs/cors"
+)
+
+// corsPolicy is a CORS handler along with the options it was created from.
+type corsPolicy struct {
+	*cors.Cors
+	options []cors.Options
+}
+
+// newCORS creates a CORS handler from the first option in opt.
+// Without any option it allows all methods, origins and headers.
+func newCORS(opt []cors.Options) *corsPolicy {
+	if len(opt) > 0 {
+		return &corsPolicy{Cors: cors.New(opt[0]), options: opt[:1]}
+	}
+
+	return &corsPolicy{Cors: cors.AllowAll()}
+}
+
+// equal reports whether p and o are created from the same options.
+// Options having functions are equal only if they are the same policy.
+func (p *corsPolicy) equal(o *corsPolicy) bool {
+	return p == o || reflect.DeepEqual(p.options, o.options)
+}
+
+// CORS creates a Middleware applying a CORS policy to the routes it is used
+// for. Without any option in argument, it allows all methods, origins and
+// headers.
+//
+// Unlike RouteHandler.CORS and Group.CORS, no OPTIONS handler is registered
+// for the routes. Preflight requests are answered only for routes having
+// an OPTIONS handler using the middleware, so prefer those when possible.
+func CORS(opt ...cors.Options) Middleware {
+	return newCORS(opt).Handler
+}
+
+// preflight is the OPTIONS handler registered automatically for routes
+// having their own CORS policy. CORS preflight requests are answered by
+// the policy itself, any other OPTIONS request is passed to next.
+type preflight struct {
+	next   http.Handler
+	policy *corsPolicy
+}
+
+func (p *preflight) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.next.ServeHTTP(w, r)
+}
+
+func noContent(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handle registers h for method and pattern in the internal http.ServeMux.
+// If an OPTIONS handler was already registered automatically for the
+// pattern's CORS policy, h replaces its fallback handler instead.
+func (m *Mux) handle(method, pattern string, h http.Handler) {
+	if method == http.MethodOptions {
+		if p, ok := m.preflights[pattern]; ok {
+			p.next = h
+			return
+		}
+	}
+
+	m.mux.Handle(joinPattern(method, pattern), h)
+}
+
+// handleWithCORS registers h wrapped with the CORS policy c and
+// registers an OPTIONS handler wrapped with mws for the pattern to answer
+// preflight requests unless there's already one. It reports whether the
+// OPTIONS handler was registered.
+//
+// It panics if the pattern already has an automatic OPTIONS handler of
+// a different policy, as the preflight requests can be answered by one
+// policy only.
+func (m *Mux) handleWithCORS(method, pattern string, h http.Handler, c *corsPolicy, mws []Middleware) bool {
+	p, ok := m.preflights[pattern]
+	if ok && method == http.MethodOptions {
+		m.handle(method, pattern, h)
+		return false
+	}
+
+	if ok && !p.policy.equal(c) {
+		panic(fmt.Sprintf("gohttputil: conflicting CORS policies for %q", pattern))
+	}
+
+	m.handle(method, pattern, c.Handler(h))
+	m.corsPatterns[joinPattern(method, pattern)] = struct{}{}
+
+	if ok || len(method) == 0 || method == http.MethodOptions || m.hasRoute(http.MethodOptions, pattern) {
+		return false
+	}
+
+	var next http.Handler
+	next = http.HandlerFunc(noContent)
+	for i := len(mws) - 1; i >= 0; i-- {
+		next = mws[i](next)
+	}
+
+	p = &preflight{next: next, policy: c}
+	m.handle(http.MethodOptions, pattern, c.Handler(p))
+	m.preflights[pattern] = p
+	m.corsPatterns[joinPattern(http.MethodOptions, pattern)] = struct{}{}
+
+	return true
+}
+
+// hasRoute reports whether a route is registered for method and pattern.
+func (m *Mux) hasRoute(method, pattern string) bool {
+	for _, r := range m.routes {
+		if r.Method == method && r.Pattern == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+// joinPattern joins method and pattern the way http.ServeMux reports them.
+func joinPattern(method, pattern string) string {
+	if len(method) > 0 {
+		return method + " " + pattern
+	}
+
+	return pattern
+}
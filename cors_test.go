@@ -0,0 +1,215 @@
+package gohttputil_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/rs/cors"
+	"github.com/stretchr/testify/assert"
+)
+
+const dashboardOrigin = "https://dashboard.example.com"
+
+func preflightRequest(path, origin, method string) *http.Request {
+	r := httptest.NewRequest(http.MethodOptions, path, nil)
+	r.Header.Add("Origin", origin)
+	r.Header.Add("Access-Control-Request-Method", method)
+	return r
+}
+
+func TestGroupCORS(t *testing.T) {
+	m := gohttputil.New()
+
+	m.EnableCORS()
+
+	m.Route("/public").Get(handler1)
+
+	m.Group("/admin").
+		CORS(cors.Options{
+			AllowedOrigins:   []string{dashboardOrigin},
+			AllowedMethods:   []string{http.MethodGet, http.MethodPost},
+			AllowCredentials: true,
+		}).
+		Group("/v1").
+		Route("/users", func(rh gohttputil.RouteHandler) {
+			rh.Get(handler1).Post(handler1)
+		})
+
+	type testCase struct {
+		request        *http.Request
+		expectedStatus int
+		expectedOrigin string
+		expectedCreds  string
+	}
+
+	actual := httptest.NewRequest(http.MethodGet, "/admin/v1/users", nil)
+	actual.Header.Add("Origin", dashboardOrigin)
+
+	cases := []testCase{
+		{preflightRequest("/public", "https://any.com", http.MethodGet), http.StatusNoContent, "*", ""},
+		{preflightRequest("/admin/v1/users", "https://any.com", http.MethodGet), http.StatusNoContent, "", ""},
+		{
+			preflightRequest("/admin/v1/users", dashboardOrigin, http.MethodPost),
+			http.StatusNoContent,
+			dashboardOrigin,
+			"true",
+		},
+		{actual, http.StatusOK, dashboardOrigin, "true"},
+	}
+
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+
+		m.ServeHTTP(w, c.request)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedOrigin, w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, c.expectedCreds, w.Header().Get("Access-Control-Allow-Credentials"))
+	}
+}
+
+func TestRouteCORSWithOptionsHandler(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users").
+		CORS().
+		Get(handler1).
+		Use(middleware1).
+		Options(handler2)
+
+	// preflight request is answered by the CORS policy
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, preflightRequest("/users", "https://any.com", http.MethodGet))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+
+	// any other OPTIONS request reaches the route's OPTIONS handler
+	checkResponse(t, m, http.MethodOptions, "/users", `{"success":true,"method":"OPTIONS"}`, http.Header{
+		"Content-Type":  {"application/json"},
+		"Vary":          {"Origin"},
+		"X-Middleware1": {"1"},
+	})
+}
+
+func TestRouteCORSWithoutOptionsHandler(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users").Get(handler1)
+	m.Route("/orders").CORS().Get(handler1)
+
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, preflightRequest("/orders", "https://any.com", http.MethodGet))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+
+	// routes without a CORS policy are not affected
+	w = httptest.NewRecorder()
+	m.ServeHTTP(w, preflightRequest("/users", "https://any.com", http.MethodGet))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "", w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestRouteCORSAutomaticOptionsHandler(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Use(globalMiddleware)
+
+	m.Group("/api").
+		Use(groupMiddleware1).
+		Route("/users", func(rh gohttputil.RouteHandler) {
+			rh.CORS().Use(middleware1).Get(handler1)
+		})
+
+	m.Route("/orders").CORS().Get(handler1).Options(handler2)
+
+	// non preflight OPTIONS requests pass through global and group middlewares
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodOptions, "/api/users", nil))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "1", w.Header().Get("X-Global-Middleware"))
+	assert.Equal(t, "1", w.Header().Get("X-Group-Middleware1"))
+	assert.Equal(t, "", w.Header().Get("X-Middleware1"))
+
+	expected := []gohttputil.RouteInfo{
+		{
+			Method:  http.MethodGet,
+			Pattern: "/api/users",
+			Prefix:  "/api",
+			Middlewares: []string{
+				testPkg + "globalMiddleware",
+				testPkg + "groupMiddleware1",
+				testPkg + "middleware1",
+			},
+		},
+		{
+			Method:  http.MethodOptions,
+			Pattern: "/api/users",
+			Prefix:  "/api",
+			Middlewares: []string{
+				testPkg + "globalMiddleware",
+				testPkg + "groupMiddleware1",
+			},
+			Automatic: true,
+		},
+		{
+			Method:      http.MethodGet,
+			Pattern:     "/orders",
+			Middlewares: []string{testPkg + "globalMiddleware"},
+		},
+		// explicit OPTIONS route replaces the automatic one
+		{
+			Method:      http.MethodOptions,
+			Pattern:     "/orders",
+			Middlewares: []string{testPkg + "globalMiddleware"},
+		},
+	}
+
+	assert.Equal(t, expected, m.Routes())
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users").
+		Use(gohttputil.CORS(cors.Options{AllowedOrigins: []string{dashboardOrigin}})).
+		Get(handler1).
+		Use(gohttputil.CORS(cors.Options{AllowedOrigins: []string{dashboardOrigin}})).
+		Options(handler2)
+
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, preflightRequest("/users", dashboardOrigin, http.MethodGet))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, dashboardOrigin, w.Header().Get("Access-Control-Allow-Origin"))
+
+	r := httptest.NewRequest(http.MethodGet, "/users", nil)
+	r.Header.Add("Origin", "https://any.com")
+	w = httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "", w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestRouteCORSConflict(t *testing.T) {
+	m := gohttputil.New()
+
+	opt := cors.Options{AllowedOrigins: []string{dashboardOrigin}}
+
+	m.Route("/users").CORS(opt).Get(handler1)
+
+	// same policy in a different route handler
+	assert.NotPanics(t, func() {
+		m.Route("/users").CORS(opt).Post(handler1)
+	})
+
+	assert.Panics(t, func() {
+		m.Route("/users").CORS().Delete(handler1)
+	})
+}
@@ -3,6 +3,9 @@ package gohttputil
 import (
 	"net/http"
 	"slices"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/rs/cors"
 )
 
 // Grouper defines interface to create a new Group.
@@ -23,12 +26,35 @@ type Group interface {
 	// Route creates a new RouteHandler under the current Group and calls GroupRouter with it.
 	// This lets user to define one or more routes under the current Group.
 	Route(string, GroupRouter) Group
+
+	// Group creates a nested Group under the current Group.
+	// The nested Group's prefix is appended to the current Group's prefix
+	// and it inherits a snapshot of the current Group's middlewares. Middlewares
+	// added to the current Group afterwards are not applied to the nested Group.
+	Group(string) Group
+
+	// Mount attaches a http.Handler to handle every request under
+	// the current Group's prefix followed by the given prefix.
+	// See Mux.Mount for details.
+	Mount(string, http.Handler) Group
+
+	// CORS sets a CORS policy for all routes defined afterwards under
+	// this Group and it's nested Groups. It overrides the global one set by
+	// Mux.EnableCORS. See RouteHandler.CORS for details.
+	CORS(...cors.Options) Group
+
+	// Responder sets the helpers.Responder used to write responses of all
+	// routes defined afterwards under this Group and it's nested Groups.
+	// It overrides the one set by Mux.Responder.
+	Responder(helpers.Responder) Group
 }
 
 type group struct {
-	mux         *http.ServeMux
+	mux         *Mux
 	prefix      string
 	middlewares []Middleware
+	cors        *corsPolicy
+	responder   helpers.Responder
 }
 
 // Use implements Group.
@@ -41,12 +67,35 @@ func (g *group) Use(middlewares ...Middleware) Group {
 func (g *group) Route(route string, router GroupRouter) Group {
 	router(&routeHandler{
 		mux:             g.mux,
-		route:           g.prefix + route,
+		prefix:          g.prefix,
+		route:           route,
 		rootMiddlewares: slices.Clone(g.middlewares),
 		middlewares:     []Middleware{},
+		cors:            g.cors,
+		responder:       g.responder,
 	})
 
 	return g
 }
 
-var _ = (Group)(&group{})
+// Group implements Group.
+func (g *group) Group(prefix string) Group {
+	return &group{
+		mux:         g.mux,
+		prefix:      g.prefix + prefix,
+		middlewares: slices.Clone(g.middlewares),
+		cors:        g.cors,
+		responder:   g.responder,
+	}
+}
+
+// CORS implements Group.
+func (g *group) CORS(opt ...cors.Options) Group {
+	g.cors = newCORS(opt)
+	return g
+}
+
+var (
+	_ = (Group)(&group{})
+	_ = (Grouper)(&group{})
+)
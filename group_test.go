@@ -170,3 +170,64 @@ func TestGroupWithoutChaining(t *testing.T) {
 		assert.Equal(t, c.expectedHeader, w.Header())
 	}
 }
+
+func TestNestedGroup(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Use(globalMiddleware)
+
+	api := m.Group("/api").Use(groupMiddleware1)
+
+	v1 := api.Group("/v1")
+
+	// added after v1 is created, so it must not be applied to v1
+	api.Use(middleware1)
+
+	v1.Group("/admin").
+		Use(groupMiddleware2).
+		Route("/users", func(rh gohttputil.RouteHandler) {
+			rh.Use(middleware2).Get(handler2)
+		})
+
+	v1.Route("/orders", func(rh gohttputil.RouteHandler) {
+		rh.Get(handler2)
+	})
+
+	api.Route("/health", func(rh gohttputil.RouteHandler) {
+		rh.Get(handler2)
+	})
+
+	type testCase struct {
+		route          string
+		method         string
+		expectedResp   string
+		expectedHeader http.Header
+	}
+
+	cases := []testCase{
+		{"/api/v1/admin/users", http.MethodGet, `{"success":true,"method":"GET"}`, http.Header{
+			"Content-Type":        {"application/json"},
+			"X-Global-Middleware": {"1"},
+			"X-Group-Middleware1": {"1"},
+			"X-Group-Middleware2": {"2"},
+			"X-Middleware2":       {"2"},
+		}},
+
+		{"/api/v1/orders", http.MethodGet, `{"success":true,"method":"GET"}`, http.Header{
+			"Content-Type":        {"application/json"},
+			"X-Global-Middleware": {"1"},
+			"X-Group-Middleware1": {"1"},
+		}},
+
+		{"/api/health", http.MethodGet, `{"success":true,"method":"GET"}`, http.Header{
+			"Content-Type":        {"application/json"},
+			"X-Global-Middleware": {"1"},
+			"X-Group-Middleware1": {"1"},
+			"X-Middleware1":       {"1"},
+		}},
+	}
+
+	for _, c := range cases {
+		checkResponse(t, m, c.method, c.route, c.expectedResp, c.expectedHeader)
+	}
+}
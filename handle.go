@@ -0,0 +1,193 @@
+package gohttputil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/asif-mahmud/go-httputil/validator"
+	golog "github.com/asif-mahmud/go-log"
+	vd "github.com/go-playground/validator/v10"
+)
+
+// HandleOptions configures how Handle reads request body.
+type HandleOptions struct {
+	maxBytes  int64
+	maxMemory int64
+}
+
+// HandleSetupFunc is the signature for setting up HandleOptions via builder function.
+type HandleSetupFunc func(*HandleOptions) *HandleOptions
+
+// HandleWithMaxBytes limits the request body to n bytes.
+// Larger bodies are responded with request entity too large status.
+// By default there's no limit.
+func HandleWithMaxBytes(n int64) HandleSetupFunc {
+	return func(o *HandleOptions) *HandleOptions {
+		o.maxBytes = n
+		return o
+	}
+}
+
+// HandleWithMaxMemory sets the maximum bytes of multipart form parts
+// kept in memory. Rest of the parts are stored in temporary files on disk.
+// Defaults to validator.DefaultMaxMemory.
+func HandleWithMaxMemory(n int64) HandleSetupFunc {
+	return func(o *HandleOptions) *HandleOptions {
+		o.maxMemory = n
+		return o
+	}
+}
+
+// HTTPError is an error carrying the http status code and the response
+// message and data to be sent to the client. Functions adapted by Handle
+// may return it to respond with a specific status code.
+type HTTPError struct {
+	// Status is the http status code.
+	Status int
+
+	// Message is the response message.
+	Message string
+
+	// Data is the response data.
+	Data any
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+// NewHTTPError creates a new HTTPError with status and message.
+func NewHTTPError(status int, message string) *HTTPError {
+	return &HTTPError{
+		Status:  status,
+		Message: message,
+	}
+}
+
+// Error implements error.
+func (e *HTTPError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%d %s: %s", e.Status, e.Message, e.Err.Error())
+	}
+
+	return fmt.Sprintf("%d %s", e.Status, e.Message)
+}
+
+// Unwrap returns the underlying error.
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// Handle adapts a typed function into a http.HandlerFunc.
+//
+// The returned handler binds request body, search queries, path values,
+// headers and cookies into a new instance of In the same way
+// validator.BindRequest does, runs it through validation and calls fn
+// with it. The returned Out is sent via helpers.Respond.
+//
+// Bodies larger than the limit set via HandleWithMaxBytes or the one set
+// by a preceding middlewares.MaxBodySize are responded with 413 status.
+// Validation failures are responded with 400 status and formatted errors.
+// Errors returned by fn are responded with the status, message and data of
+// HTTPError if it is one, with 400 status and formatted errors if it is a
+// validation error or with 500 status otherwise.
+//
+// Example -
+//
+//	type GetUserInput struct {
+//		Id int `path:"id" validate:"gt=0"`
+//	}
+//
+//	mux.Route("/users/{id}").Get(gohttputil.Handle(
+//		func(ctx context.Context, in *GetUserInput) (*User, error) {
+//			return users.Find(ctx, in.Id)
+//		},
+//	))
+func Handle[In, Out any](fn func(context.Context, *In) (Out, error), setupFuncs ...HandleSetupFunc) http.HandlerFunc {
+	opts := &HandleOptions{}
+	for _, f := range setupFuncs {
+		opts = f(opts)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if opts.maxBytes > 0 && r.Body != nil {
+			if r.ContentLength > opts.maxBytes {
+				entityTooLarge(w, r)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, opts.maxBytes)
+		}
+
+		in := new(In)
+		ctx := validator.WithLocale(r.Context(), validator.RequestLocale(r))
+
+		err := validator.BindRequestWithOptions(r.Context(), r, in, validator.RequestOptions{
+			MaxMemory: opts.maxMemory,
+		})
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				entityTooLarge(w, r)
+				return
+			}
+
+			if errors.Is(err, validator.ErrMalformedJSON) {
+				helpers.RespondError(w, r, http.StatusBadRequest, "Malformed JSON", nil)
+				return
+			}
+
+			if data, ok := validator.FormatBindErrorContext(ctx, err); ok {
+				helpers.RespondError(w, r, http.StatusBadRequest, "Validation error", data)
+				return
+			}
+
+			slog.Error("Failed to bind payload", golog.Extra(map[string]any{
+				"error": err.Error(),
+			}))
+			helpers.RespondError(w, r, http.StatusBadRequest, helpers.ErrorMsg, nil)
+			return
+		}
+
+		if err := validator.ValidateStruct(r.Context(), in); err != nil {
+			sendHandlerError(ctx, w, r, err)
+			return
+		}
+
+		out, err := fn(r.Context(), in)
+		if err != nil {
+			sendHandlerError(ctx, w, r, err)
+			return
+		}
+
+		helpers.Respond(w, r, http.StatusOK, out)
+	}
+}
+
+// entityTooLarge responds with request entity too large status.
+func entityTooLarge(w http.ResponseWriter, r *http.Request) {
+	helpers.RespondError(w, r, http.StatusRequestEntityTooLarge, "Request entity too large", nil)
+}
+
+// sendHandlerError responds with the status code matching err.
+// Validation errors are translated to the locale carried by ctx.
+func sendHandlerError(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		helpers.RespondError(w, r, httpErr.Status, httpErr.Message, httpErr.Data)
+		return
+	}
+
+	var validationErr vd.ValidationErrors
+	if errors.As(err, &validationErr) {
+		helpers.RespondError(w, r, http.StatusBadRequest, "Validation error", validator.FormatErrorsContext(ctx, validationErr))
+		return
+	}
+
+	slog.Error("Failed to handle request", golog.Extra(map[string]any{
+		"error": err.Error(),
+	}))
+	helpers.RespondError(w, r, http.StatusInternalServerError, helpers.ErrorMsg, nil)
+}
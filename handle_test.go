@@ -0,0 +1,243 @@
+package gohttputil_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+type updateUserInput struct {
+	Id     int    `json:"-"      path:"id" validate:"gt=0"`
+	Notify bool   `json:"-"      query:"notify"`
+	Name   string `json:"name"   form:"name" validate:"required,min=3"`
+}
+
+type updateUserOutput struct {
+	Id     int    `json:"id"`
+	Notify bool   `json:"notify"`
+	Name   string `json:"name"`
+}
+
+var errUserNotFound = errors.New("user not found")
+
+func updateUser(ctx context.Context, in *updateUserInput) (updateUserOutput, error) {
+	switch in.Id {
+	case 404:
+		return updateUserOutput{}, &gohttputil.HTTPError{
+			Status:  http.StatusNotFound,
+			Message: "User not found",
+			Err:     errUserNotFound,
+		}
+
+	case 500:
+		return updateUserOutput{}, errUserNotFound
+	}
+
+	return updateUserOutput{in.Id, in.Notify, in.Name}, nil
+}
+
+func TestHandle(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users/{id}").
+		Put(gohttputil.Handle(updateUser)).
+		Patch(gohttputil.Handle(updateUser))
+
+	type testCase struct {
+		method           string
+		path             string
+		contentType      string
+		body             string
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	form := url.Values{"name": {"Asif"}}
+
+	cases := []testCase{
+		{
+			http.MethodPut,
+			"/users/1?notify=true",
+			"application/json",
+			`{"name":"Asif"}`,
+			http.StatusOK,
+			`{"data":{"id":1,"notify":true,"name":"Asif"},"message":"Success","status":true}`,
+		},
+		{
+			http.MethodPatch,
+			"/users/2",
+			"application/x-www-form-urlencoded",
+			form.Encode(),
+			http.StatusOK,
+			`{"data":{"id":2,"notify":false,"name":"Asif"},"message":"Success","status":true}`,
+		},
+		{
+			http.MethodPut,
+			"/users/0",
+			"application/json",
+			`{"name":"As"}`,
+			http.StatusBadRequest,
+			`{"data":{"id":"id must be greater than 0","name":"name must be at least 3 characters in length"},"message":"Validation error","status":false}`,
+		},
+		{
+			http.MethodPut,
+			"/users/404",
+			"application/json",
+			`{"name":"Asif"}`,
+			http.StatusNotFound,
+			`{"data":null,"message":"User not found","status":false}`,
+		},
+		{
+			http.MethodPut,
+			"/users/500",
+			"application/json",
+			`{"name":"Asif"}`,
+			http.StatusInternalServerError,
+			`{"data":null,"message":"Sorry, something went wrong! Please try again later.","status":false}`,
+		},
+		{
+			http.MethodPut,
+			"/users/1",
+			"application/json",
+			`{"name":`,
+			http.StatusBadRequest,
+			`{"data":null,"message":"Malformed JSON","status":false}`,
+		},
+		{
+			http.MethodPut,
+			"/users/1",
+			"application/json",
+			`{"name":1}`,
+			http.StatusBadRequest,
+			`{"data":{"name":"name must be a string"},"message":"Validation error","status":false}`,
+		},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(c.method, c.path, strings.NewReader(c.body))
+		r.Header.Add("Content-Type", c.contentType)
+		w := httptest.NewRecorder()
+
+		m.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestHandleSlice(t *testing.T) {
+	type item struct {
+		Value string `json:"value" validate:"required"`
+	}
+
+	h := gohttputil.Handle(func(ctx context.Context, in *[]item) (int, error) {
+		return len(*in), nil
+	})
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"value":"1"},{"value":"2"}]`))
+	r.Header.Add("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"data":2,"message":"Success","status":true}`, w.Body.String())
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"value":""}]`))
+	r.Header.Add("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, `{"data":[{"value":"value is a required field"}],"message":"Validation error","status":false}`, w.Body.String())
+}
+
+func TestHandleFiles(t *testing.T) {
+	type input struct {
+		Title  string                `form:"title"  validate:"required"`
+		Avatar *multipart.FileHeader `form:"avatar" validate:"required,maxfilesize=1KB"`
+	}
+
+	h := gohttputil.Handle(func(ctx context.Context, in *input) (string, error) {
+		return in.Avatar.Filename, nil
+	})
+
+	var b bytes.Buffer
+	mw := multipart.NewWriter(&b)
+	_ = mw.WriteField("title", "Avatar")
+	fw, _ := mw.CreateFormFile("avatar", "avatar.png")
+	_, _ = fw.Write([]byte("\x89PNG\r\n\x1a\n"))
+	_ = mw.Close()
+
+	r := httptest.NewRequest(http.MethodPost, "/", &b)
+	r.Header.Add("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"data":"avatar.png","message":"Success","status":true}`, w.Body.String())
+}
+
+func TestHandleMaxBytes(t *testing.T) {
+	type input struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	h := gohttputil.Handle(func(ctx context.Context, in *input) (string, error) {
+		return in.Name, nil
+	}, gohttputil.HandleWithMaxBytes(16))
+
+	type testCase struct {
+		body           io.Reader
+		expectedStatus int
+	}
+
+	cases := []testCase{
+		{strings.NewReader(`{"name":"Asif"}`), http.StatusOK},
+		{strings.NewReader(`{"name":"Asif Mahmud"}`), http.StatusRequestEntityTooLarge},
+		{io.MultiReader(strings.NewReader(`{"name":"Asif Mahmud"}`)), http.StatusRequestEntityTooLarge},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodPost, "/", c.body)
+		r.Header.Add("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+	}
+}
+
+func TestHandleMaxBodySize(t *testing.T) {
+	type input struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	h := middlewares.MaxBodySize(16)(gohttputil.Handle(func(ctx context.Context, in *input) (string, error) {
+		return in.Name, nil
+	}))
+
+	// unknown content length, the limit is hit while decoding
+	r := httptest.NewRequest(http.MethodPost, "/", io.MultiReader(strings.NewReader(`{"name":"Asif Mahmud"}`)))
+	r.Header.Add("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, `{"data":null,"message":"Request entity too large","status":false}`, w.Body.String())
+}
@@ -41,7 +41,7 @@ func swaggerHandlerFactory(
 		// for swagger json file
 		if strings.HasSuffix(filePath, "swagger.json") {
 			if docData == nil || len(docData) == 0 {
-				helpers.SendError(w, http.StatusNotFound, "File not found", nil)
+				helpers.RespondError(w, r, http.StatusNotFound, "File not found", nil)
 				return
 			}
 			w.Header().Add("Content-Type", "application/json")
@@ -53,7 +53,7 @@ func swaggerHandlerFactory(
 		// for static dist files
 		data, err := fs.ReadFile(fsys, path.Join(fsRootDir, filePath))
 		if err != nil {
-			helpers.SendError(w, http.StatusNotFound, "File not found", nil)
+			helpers.RespondError(w, r, http.StatusNotFound, "File not found", nil)
 			return
 		}
 		ext := path.Ext(filePath)
@@ -0,0 +1,128 @@
+package helpers
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ProblemContentType is the media type of Problem Details responses.
+const ProblemContentType = "application/problem+json"
+
+// Problem is the RFC 9457 Problem Details response structure.
+//
+// The response structure will be -
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "Bad Request",
+//	  "status": 400,
+//	  "detail": "Validation error",
+//	  "instance": "/users",
+//	  "errors": {
+//	    "name": "name is a required field"
+//	  }
+//	}
+type Problem struct {
+	// Type is a URI reference identifying the problem type.
+	Type string `json:"type"`
+
+	// Title is a short summary of the problem type.
+	Title string `json:"title"`
+
+	// Status is the HTTP status code.
+	Status int `json:"status"`
+
+	// Detail is an explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+
+	// Instance is a URI reference identifying this occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+
+	// Errors is an extension member containing the error data,
+	// i.e formatted validation errors.
+	Errors any `json:"errors,omitempty"`
+}
+
+// ErrorFormat defines how error responses are rendered.
+type ErrorFormat int
+
+const (
+	// ErrorFormatEnvelope renders errors in the pre-defined response structure.
+	// This is the default.
+	ErrorFormatEnvelope ErrorFormat = iota
+
+	// ErrorFormatProblem renders errors as Problem Details.
+	ErrorFormatProblem
+
+	// ErrorFormatNegotiate renders errors as Problem Details if the request
+	// accepts application/problem+json, otherwise in the pre-defined
+	// response structure.
+	ErrorFormatNegotiate
+)
+
+var errorFormat = ErrorFormatEnvelope
+
+// SetErrorFormat sets how error responses are rendered by EnvelopeResponder,
+// the default Responder used by SendError, RespondError and the middlewares.
+func SetErrorFormat(f ErrorFormat) {
+	errorFormat = f
+}
+
+// NewProblem creates a Problem for the status code. Title is set to
+// the status text and Instance is set to the request path if r is not nil.
+func NewProblem(r *http.Request, status int, detail string, errors any) Problem {
+	p := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Errors: errors,
+	}
+
+	if r != nil {
+		p.Instance = r.URL.Path
+	}
+
+	return p
+}
+
+// SendProblem writes p with Problem Details content type.
+func SendProblem(w http.ResponseWriter, p Problem) {
+	writeJSON(w, p.Status, ProblemContentType, p)
+}
+
+// wantsProblem reports if the error response for r should be rendered
+// as Problem Details. r can be nil.
+func wantsProblem(r *http.Request) bool {
+	switch errorFormat {
+	case ErrorFormatProblem:
+		return true
+	case ErrorFormatNegotiate:
+		return r != nil && acceptsProblem(r.Header.Get("Accept"))
+	default:
+		return false
+	}
+}
+
+// acceptsProblem reports if the Accept header value accepts
+// application/problem+json.
+func acceptsProblem(accept string) bool {
+	for part := range strings.SplitSeq(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != ProblemContentType {
+			continue
+		}
+
+		if q, ok := params["q"]; ok {
+			if v, err := strconv.ParseFloat(q, 64); err != nil || v <= 0 {
+				continue
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
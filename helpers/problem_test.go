@@ -0,0 +1,62 @@
+package helpers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespondError(t *testing.T) {
+	defer helpers.SetErrorFormat(helpers.ErrorFormatEnvelope)
+
+	const (
+		envelope = `{"data":{"name":"name is a required field"},"message":"Validation error","status":false}`
+		problem  = `{"type":"about:blank","title":"Bad Request","status":400,"detail":"Validation error","instance":"/users","errors":{"name":"name is a required field"}}`
+	)
+
+	type testCase struct {
+		format              helpers.ErrorFormat
+		accept              string
+		expectedContentType string
+		expectedResponse    string
+	}
+
+	testCases := []testCase{
+		{helpers.ErrorFormatEnvelope, helpers.ProblemContentType, "application/json", envelope},
+		{helpers.ErrorFormatProblem, "", helpers.ProblemContentType, problem},
+		{helpers.ErrorFormatNegotiate, "application/json", "application/json", envelope},
+		{helpers.ErrorFormatNegotiate, "application/json, application/problem+json;q=0.5", helpers.ProblemContentType, problem},
+		{helpers.ErrorFormatNegotiate, "application/problem+json;q=0", "application/json", envelope},
+	}
+
+	for _, c := range testCases {
+		helpers.SetErrorFormat(c.format)
+
+		r := httptest.NewRequest(http.MethodPost, "/users", nil)
+		r.Header.Set("Accept", c.accept)
+		w := httptest.NewRecorder()
+
+		helpers.RespondError(w, r, http.StatusBadRequest, "Validation error", map[string]any{
+			"name": "name is a required field",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, c.expectedContentType, w.Header().Get("Content-Type"))
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestSendErrorProblem(t *testing.T) {
+	helpers.SetErrorFormat(helpers.ErrorFormatProblem)
+	defer helpers.SetErrorFormat(helpers.ErrorFormatEnvelope)
+
+	w := httptest.NewRecorder()
+	helpers.SendError(w, http.StatusNotFound, "File not found", nil)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, helpers.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"File not found"}`, w.Body.String())
+}
@@ -0,0 +1,89 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+)
+
+// Responder writes the responses of successful and failed requests.
+// Implement it to use a response structure other than the pre-defined one,
+// i.e {"ok", "error", "result"} or bare payloads.
+type Responder interface {
+	// Data writes data with the status code.
+	Data(w http.ResponseWriter, r *http.Request, status int, data any)
+
+	// Error writes an error message and data with the status code.
+	Error(w http.ResponseWriter, r *http.Request, status int, message string, data any)
+}
+
+// EnvelopeResponder is the default Responder which writes responses in
+// the pre-defined structure. See SendData and SendError.
+//
+// Errors are rendered as Problem Details depending on the format set
+// via SetErrorFormat.
+type EnvelopeResponder struct{}
+
+// Data implements Responder.
+func (EnvelopeResponder) Data(w http.ResponseWriter, r *http.Request, status int, data any) {
+	SendJSON(w, status, map[string]any{
+		"status":  true,
+		"message": "Success",
+		"data":    data,
+	})
+}
+
+// Error implements Responder.
+func (EnvelopeResponder) Error(w http.ResponseWriter, r *http.Request, status int, message string, data any) {
+	if wantsProblem(r) {
+		SendProblem(w, NewProblem(r, status, message, data))
+		return
+	}
+
+	SendJSON(w, status, map[string]any{
+		"status":  false,
+		"message": message,
+		"data":    data,
+	})
+}
+
+var defaultResponder Responder = EnvelopeResponder{}
+
+// SetResponder sets the global Responder used when the request
+// context doesn't carry one.
+func SetResponder(rs Responder) {
+	defaultResponder = rs
+}
+
+type responderCtxKey struct{}
+
+// WithResponder returns a copy of ctx carrying rs.
+// Responses of the request using the context are written by rs.
+func WithResponder(ctx context.Context, rs Responder) context.Context {
+	return context.WithValue(ctx, responderCtxKey{}, rs)
+}
+
+// ResponderFrom returns the Responder carried by the request context
+// or the global one set via SetResponder. r can be nil.
+func ResponderFrom(r *http.Request) Responder {
+	if r != nil {
+		if rs, ok := r.Context().Value(responderCtxKey{}).(Responder); ok {
+			return rs
+		}
+	}
+
+	return defaultResponder
+}
+
+// Respond writes data with the status code via the request's Responder.
+func Respond(w http.ResponseWriter, r *http.Request, status int, data any) {
+	ResponderFrom(r).Data(w, r, status, data)
+}
+
+// RespondError writes an error response via the request's Responder.
+// With the default Responder message becomes the detail and data
+// becomes the errors extension member of Problem Details.
+func RespondError(w http.ResponseWriter, r *http.Request, status int, message string, data any) {
+	ResponderFrom(r).Error(w, r, status, message, data)
+}
+
+var _ = (Responder)(EnvelopeResponder{})
@@ -0,0 +1,94 @@
+package helpers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+type resultResponder struct{}
+
+func (resultResponder) Data(w http.ResponseWriter, r *http.Request, status int, data any) {
+	helpers.SendJSON(w, status, map[string]any{"ok": true, "result": data})
+}
+
+func (resultResponder) Error(w http.ResponseWriter, r *http.Request, status int, message string, data any) {
+	helpers.SendJSON(w, status, map[string]any{"ok": false, "error": message})
+}
+
+func TestResponder(t *testing.T) {
+	type testCase struct {
+		send             func(w http.ResponseWriter, r *http.Request)
+		withResponder    bool
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	testCases := []testCase{
+		{
+			func(w http.ResponseWriter, r *http.Request) { helpers.Respond(w, r, http.StatusCreated, 1) },
+			false,
+			http.StatusCreated,
+			`{"data":1,"message":"Success","status":true}`,
+		},
+		{
+			func(w http.ResponseWriter, r *http.Request) { helpers.Respond(w, r, http.StatusCreated, 1) },
+			true,
+			http.StatusCreated,
+			`{"ok":true,"result":1}`,
+		},
+		{
+			func(w http.ResponseWriter, r *http.Request) {
+				helpers.RespondError(w, r, http.StatusNotFound, "Not found", nil)
+			},
+			true,
+			http.StatusNotFound,
+			`{"error":"Not found","ok":false}`,
+		},
+		{
+			func(w http.ResponseWriter, r *http.Request) { helpers.SendData(w, 1) },
+			true,
+			http.StatusOK,
+			`{"data":1,"message":"Success","status":true}`,
+		},
+		{
+			func(w http.ResponseWriter, r *http.Request) { helpers.RespondPage(w, r, helpers.Page{Items: []int{1}}) },
+			true,
+			http.StatusOK,
+			`{"ok":true,"result":{"items":[1],"itemsPerPage":0,"pageNumber":0,"totalItems":0,"totalPages":0}}`,
+		},
+		{
+			func(w http.ResponseWriter, r *http.Request) { helpers.SendPage(w, helpers.Page{Items: []int{1}}) },
+			true,
+			http.StatusOK,
+			`{"data":{"items":[1],"itemsPerPage":0,"pageNumber":0,"totalItems":0,"totalPages":0},"message":"Success","status":true}`,
+		},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if c.withResponder {
+			r = r.WithContext(helpers.WithResponder(r.Context(), resultResponder{}))
+		}
+		w := httptest.NewRecorder()
+
+		c.send(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestSetResponder(t *testing.T) {
+	helpers.SetResponder(resultResponder{})
+	defer helpers.SetResponder(helpers.EnvelopeResponder{})
+
+	w := httptest.NewRecorder()
+	helpers.SendError(w, http.StatusBadRequest, "Invalid request", nil)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, `{"error":"Invalid request","ok":false}`, w.Body.String())
+}
@@ -11,10 +11,9 @@ import "net/http"
 //	  "message": "Success",
 //	  "data": data
 //	}
+//
+// It always uses the global Responder set via SetResponder, ignoring the
+// ones set per Mux or group. Use Respond to use the request's Responder.
 func SendData(w http.ResponseWriter, data interface{}) {
-	SendJSON(w, http.StatusOK, map[string]interface{}{
-		"status":  true,
-		"message": "Success",
-		"data":    data,
-	})
+	Respond(w, nil, http.StatusOK, data)
 }
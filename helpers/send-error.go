@@ -11,10 +11,11 @@ import "net/http"
 //	   "message": message,
 //	   "data": data
 //	}
+//
+// If the error format is set to ErrorFormatProblem the response is rendered
+// as Problem Details instead. It always uses the global Responder set via
+// SetResponder, ignoring the ones set per Mux or group. Use RespondError to
+// negotiate the format with the request and to use the request's Responder.
 func SendError(w http.ResponseWriter, status int, message string, data interface{}) {
-	SendJSON(w, status, map[string]any{
-		"status":  false,
-		"message": message,
-		"data":    data,
-	})
+	RespondError(w, nil, status, message, data)
 }
@@ -16,7 +16,12 @@ const jsonErrorMsg = `{"status":false,"message":` + ErrorMsg + `,"data":null}`
 
 // SendJSON writes JSON data to w.
 func SendJSON(w http.ResponseWriter, status int, data any) {
-	w.Header().Add("Content-Type", "application/json")
+	writeJSON(w, status, "application/json", data)
+}
+
+// writeJSON writes JSON data to w with the content type.
+func writeJSON(w http.ResponseWriter, status int, contentType string, data any) {
+	w.Header().Add("Content-Type", contentType)
 
 	str, err := json.Marshal(data)
 	if err != nil {
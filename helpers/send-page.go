@@ -35,6 +35,15 @@ type Page struct {
 //	     "items": []any
 //	   }
 //	}
+//
+// It always uses the global Responder set via SetResponder, ignoring the
+// ones set per Mux or group. Use RespondPage to use the request's Responder.
 func SendPage(w http.ResponseWriter, page Page) {
 	SendData(w, page)
 }
+
+// RespondPage writes a pagination response via the request's Responder.
+// See SendPage for the response structure.
+func RespondPage(w http.ResponseWriter, r *http.Request, page Page) {
+	Respond(w, r, http.StatusOK, page)
+}
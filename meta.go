@@ -0,0 +1,49 @@
+package gohttputil
+
+import (
+	"context"
+	"maps"
+	"net/http"
+)
+
+// Meta is a collection of arbitrary values attached to a route via
+// RouteHandler.Meta, i.e required permissions, rate limit class or
+// deprecation flag.
+type Meta map[string]any
+
+// metaCtxKey is the request context key for route metadata.
+type metaCtxKey struct{}
+
+// withMeta stores meta in the request context before calling next.
+func withMeta(meta Meta, next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), metaCtxKey{}, meta)))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// RouteMeta returns the metadata of the route matching the request.
+// Metadata is available to all middlewares applied to the route including
+// the global ones. It returns nil if the route has no metadata.
+func RouteMeta(r *http.Request) Meta {
+	meta, _ := r.Context().Value(metaCtxKey{}).(Meta)
+	return meta
+}
+
+// MetaValue returns the route metadata value for key converted to T.
+// The second return value is false if the key does not exist or the
+// value is not of type T.
+func MetaValue[T any](r *http.Request, key string) (T, bool) {
+	v, ok := RouteMeta(r)[key].(T)
+	return v, ok
+}
+
+// clone returns a shallow copy of m.
+func (m Meta) clone() Meta {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return maps.Clone(m)
+}
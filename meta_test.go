@@ -0,0 +1,64 @@
+package gohttputil_test
+
+import (
+	"net/http"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func permissionMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := gohttputil.MetaValue[string](r, "permission"); ok {
+			w.Header().Add("X-Permission", p)
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func TestMeta(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Use(permissionMiddleware)
+
+	m.Route("/users").
+		Meta("permission", "users.read").
+		Get(handler2).
+		Post(handler2).
+		Meta("permission", "users.delete").
+		Meta("deprecated", true).
+		Delete(func(w http.ResponseWriter, r *http.Request) {
+			_, ok := gohttputil.MetaValue[bool](r, "deprecated")
+			assert.True(t, ok)
+
+			_, ok = gohttputil.MetaValue[int](r, "deprecated")
+			assert.False(t, ok)
+
+			handler2(w, r)
+		})
+
+	checkResponse(t, m, http.MethodGet, "/users", `{"success":true,"method":"GET"}`, http.Header{
+		"Content-Type": {"application/json"},
+		"X-Permission": {"users.read"},
+	})
+
+	checkResponse(t, m, http.MethodPost, "/users", `{"success":true,"method":"POST"}`, http.Header{
+		"Content-Type": {"application/json"},
+	})
+
+	checkResponse(t, m, http.MethodDelete, "/users", `{"success":true,"method":"DELETE"}`, http.Header{
+		"Content-Type": {"application/json"},
+		"X-Permission": {"users.delete"},
+	})
+
+	routes := m.Routes()
+
+	assert.Equal(t, gohttputil.Meta{"permission": "users.read"}, routes[0].Meta)
+	assert.Nil(t, routes[1].Meta)
+	assert.Equal(
+		t,
+		gohttputil.Meta{"permission": "users.delete", "deprecated": true},
+		routes[2].Meta,
+	)
+}
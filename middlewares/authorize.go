@@ -16,7 +16,7 @@ func Authorize(f AuthorizeFunc) gohttputil.Middleware {
 	m := func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			if !f(r) {
-				unauthorizedResponse(w)
+				unauthorizedResponse(w, r)
 				return
 			}
 
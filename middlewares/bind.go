@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"net/http"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/asif-mahmud/go-httputil/validator"
+)
+
+// Bind binds path values, search queries, headers, cookies and request body
+// into a single dto instance, validates it and stores validated payload in
+// the request's context. See validator.BindRequest for the supported tags.
+//
+// Validation errors from all the sources are reported together.
+//
+// Body size limits can be set via PayloadWithMaxBytes and PayloadWithMaxMemory,
+// JSON decoding via PayloadWithJSONOptions or PayloadWithStrictJSON and detailed
+// validation errors can be enabled via PayloadWithDetailedErrors.
+func Bind(dto any, setupFuncs ...PayloadSetupFunc) gohttputil.Middleware {
+	opts := newPayloadOptions(setupFuncs)
+	m := func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if !limitBody(w, r, opts.maxBytes) {
+				return
+			}
+
+			validatePayload(
+				next,
+				dto,
+				func(p any) error {
+					return validator.BindRequestWithOptions(r.Context(), r, p, validator.RequestOptions{
+						JSON:      opts.jsonOptions,
+						MaxMemory: opts.maxMemory,
+					})
+				},
+				bindCtxKey,
+				opts,
+			).ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+
+	return m
+}
+
+// BindPayload returns the validated payload stored in
+// request's context by Bind.
+func BindPayload(r *http.Request) any {
+	return r.Context().Value(bindCtxKey)
+}
+
+// Bound returns the validated payload stored in request's context
+// as *T, where T is the type of the dto passed to Bind.
+// The second return value is false if there's no payload of type T.
+func Bound[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, bindCtxKey)
+}
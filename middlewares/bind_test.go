@@ -0,0 +1,123 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBind(t *testing.T) {
+	type dto struct {
+		Id       int      `json:"-"    path:"id"              validate:"gt=0"`
+		Tags     []string `json:"tags" query:"tag"`
+		Page     *int     `json:"page" query:"page"`
+		TenantId string   `json:"tenantId" header:"X-Tenant-ID" validate:"required"`
+		Session  string   `json:"session" cookie:"session"     validate:"required"`
+		Name     string   `json:"name" mod:"trim"               validate:"required,min=3"`
+	}
+
+	m := gohttputil.New()
+
+	m.Route("/users/{id}").
+		Use(middlewares.Bind(dto{})).
+		Put(func(w http.ResponseWriter, r *http.Request) {
+			d, ok := middlewares.Bound[dto](r)
+			assert.True(t, ok)
+			helpers.SendData(w, d)
+		})
+
+	type testCase struct {
+		path             string
+		body             string
+		tenantId         string
+		session          string
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	cases := []testCase{
+		{
+			"/users/1?tag=a&tag=b&page=2",
+			`{"name":"  Asif  ","tags":["ignored"]}`,
+			"t1",
+			"s1",
+			http.StatusOK,
+			`{"data":{"tags":["a","b"],"page":2,"tenantId":"t1","session":"s1","name":"Asif"},"message":"Success","status":true}`,
+		},
+		{
+			"/users/0",
+			`{"name":"As"}`,
+			"",
+			"",
+			http.StatusBadRequest,
+			`{"data":{"X-Tenant-ID":"X-Tenant-ID is a required field","id":"id must be greater than 0","name":"name must be at least 3 characters in length","session":"session is a required field"},"message":"Validation error","status":false}`,
+		},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodPut, c.path, strings.NewReader(c.body))
+		r.Header.Add("Content-Type", "application/json")
+		if len(c.tenantId) > 0 {
+			r.Header.Add("X-Tenant-ID", c.tenantId)
+		}
+		if len(c.session) > 0 {
+			r.AddCookie(&http.Cookie{Name: "session", Value: c.session})
+		}
+		w := httptest.NewRecorder()
+
+		m.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestBindStrictJSON(t *testing.T) {
+	type dto struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	strict := middlewares.Bind(dto{}, middlewares.PayloadWithStrictJSON())(http.HandlerFunc(okHandler))
+	lenient := middlewares.Bind(dto{})(http.HandlerFunc(okHandler))
+
+	type testCase struct {
+		handler          http.Handler
+		payload          string
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	testCases := []testCase{
+		{strict, `{"name":"Asif"}`, http.StatusOK, ""},
+		{
+			strict,
+			`{"name":"Asif","nmae":"Asif"}`,
+			http.StatusBadRequest,
+			`{"data":{"nmae":"nmae is not a valid field"},"message":"Validation error","status":false}`,
+		},
+		{
+			strict,
+			`{"name":"Asif"} garbage`,
+			http.StatusBadRequest,
+			`{"data":null,"message":"Malformed JSON","status":false}`,
+		},
+		{lenient, `{"name":"Asif","nmae":"Asif"} garbage`, http.StatusOK, ""},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(c.payload))
+		r.Header.Add("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		c.handler.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
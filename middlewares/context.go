@@ -0,0 +1,26 @@
+package middlewares
+
+import "net/http"
+
+// ctxKey is the type of request context keys set by this package.
+// Being unexported it can not collide with keys of other packages.
+type ctxKey int
+
+const (
+	jsonCtxKey ctxKey = iota
+	queryCtxKey
+	formCtxKey
+	pathValueCtxKey
+	jwtPayloadKey
+	bindCtxKey
+	headerCtxKey
+	cookieCtxKey
+)
+
+// payload returns the request context value for key as *T.
+// The second return value is false if there's no value or
+// the value is not of type *T.
+func payload[T any](r *http.Request, key ctxKey) (*T, bool) {
+	v, ok := r.Context().Value(key).(*T)
+	return v, ok
+}
@@ -0,0 +1,300 @@
+package middlewares
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	golog "github.com/asif-mahmud/go-log"
+)
+
+// ErrUnknownKeyID is returned when a key set has no key for the kid header.
+var ErrUnknownKeyID = errors.New("unknown key id")
+
+// KeySet provides public keys by their key id, the kid header of the token.
+type KeySet interface {
+	Key(kid string) (crypto.PublicKey, error)
+}
+
+// JWTWithKeySet sets the key set used to verify tokens having a kid header.
+// Tokens without kid header are verified with the secret or the public key.
+func JWTWithKeySet(ks KeySet) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.keySet = ks
+		return j
+	}
+}
+
+// jwk is a JSON Web Key as defined in RFC 7517.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey converts k into a RSA, ECDSA or Ed25519 public key.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: invalid Ed25519 key size", ErrUnsupportedKey)
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("%w: kty %s", ErrUnsupportedKey, k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
+
+// ParseJWKS parses a JWKS document into a map of public keys indexed
+// by kid. Keys meant for encryption and keys of unsupported types,
+// i.e symmetric ones, are skipped.
+func ParseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, k := range doc.Keys {
+		if k.Use == "enc" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if errors.Is(err, ErrUnsupportedKey) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = key
+	}
+
+	return keys, nil
+}
+
+// JWKS is a KeySet loaded from a JWKS document.
+//
+// When loaded from an URL, keys are cached and fetched again after the refresh
+// interval. An unknown kid triggers an early fetch, at most once per minimum
+// refresh interval, so that rotated keys are picked up.
+type JWKS struct {
+	mu         sync.RWMutex
+	fetchMu    sync.Mutex
+	keys       map[string]crypto.PublicKey
+	url        string
+	client     *http.Client
+	refresh    time.Duration
+	minRefresh time.Duration
+	checkedAt  time.Time
+}
+
+// DefaultJWKSRefresh is the default refresh interval of JWKS loaded from URL.
+const DefaultJWKSRefresh = time.Hour
+
+// minJWKSRefresh limits fetching on unknown kid.
+const minJWKSRefresh = time.Minute
+
+// NewJWKS creates a JWKS from a JWKS document.
+func NewJWKS(data []byte) (*JWKS, error) {
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JWKS{keys: keys}, nil
+}
+
+// NewJWKSFromFile creates a JWKS from a JWKS document file.
+func NewJWKSFromFile(name string) (*JWKS, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJWKS(data)
+}
+
+// NewJWKSFromURL creates a JWKS fetching the document from url.
+// Keys are fetched again every refresh interval, DefaultJWKSRefresh is used
+// if refresh is not positive. The initial fetch must succeed, later failures
+// are logged and the cached keys are kept.
+func NewJWKSFromURL(url string, refresh time.Duration) (*JWKS, error) {
+	if refresh <= 0 {
+		refresh = DefaultJWKSRefresh
+	}
+
+	ks := &JWKS{
+		url:        url,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		refresh:    refresh,
+		minRefresh: min(refresh, minJWKSRefresh),
+	}
+
+	if err := ks.fetch(); err != nil {
+		return nil, err
+	}
+	ks.checkedAt = time.Now()
+
+	return ks, nil
+}
+
+// fetch loads the keys from url.
+func (ks *JWKS) fetch() error {
+	res, err := ks.client.Get(ks.url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch jwks: %s", res.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.mu.Unlock()
+
+	return nil
+}
+
+// lookup returns the cached key for kid and if the cache should be refreshed.
+func (ks *JWKS) lookup(kid string) (crypto.PublicKey, bool, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	key, ok := ks.keys[kid]
+	if len(ks.url) == 0 {
+		return key, ok, false
+	}
+
+	age := time.Since(ks.checkedAt)
+	stale := age > ks.refresh || (!ok && age > ks.minRefresh)
+
+	return key, ok, stale
+}
+
+// Key implements KeySet.
+func (ks *JWKS) Key(kid string) (crypto.PublicKey, error) {
+	key, ok, stale := ks.lookup(kid)
+	if stale {
+		key, ok = ks.refreshKey(kid)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyID, kid)
+	}
+
+	return key, nil
+}
+
+// refreshKey fetches the keys again, unless another goroutine just did,
+// and returns the key for kid.
+func (ks *JWKS) refreshKey(kid string) (crypto.PublicKey, bool) {
+	ks.fetchMu.Lock()
+	defer ks.fetchMu.Unlock()
+
+	key, ok, stale := ks.lookup(kid)
+	if !stale {
+		return key, ok
+	}
+
+	err := ks.fetch()
+
+	ks.mu.Lock()
+	ks.checkedAt = time.Now()
+	ks.mu.Unlock()
+
+	if err != nil {
+		slog.Error("Failed to refresh JWKS", golog.Extra(map[string]any{
+			"url":   ks.url,
+			"error": err.Error(),
+		}))
+	}
+
+	key, ok, _ = ks.lookup(kid)
+	return key, ok
+}
+
+var _ = (KeySet)(&JWKS{})
@@ -0,0 +1,139 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTWithIssuer rejects tokens not having iss claim set to issuer.
+func JWTWithIssuer(issuer string) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.issuer = issuer
+		return j
+	}
+}
+
+// JWTWithAudience rejects tokens not having audience in their aud claim.
+func JWTWithAudience(audience string) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.audience = audience
+		return j
+	}
+}
+
+// JWTWithLeeway sets the leeway allowed when validating exp, nbf and iat
+// claims to account for clock skew.
+func JWTWithLeeway(leeway time.Duration) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.leeway = leeway
+		return j
+	}
+}
+
+// JWTWithRequiredClaims rejects tokens missing any of the claims,
+// i.e "exp", "sub" or "jti". Claims set to null, an empty string,
+// an empty array or an empty object are considered missing.
+func JWTWithRequiredClaims(claims ...string) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.requiredClaims = slices.Clone(claims)
+		return j
+	}
+}
+
+// parserOptions returns the jwt parser options for the JWT's setup.
+func (j *JWT) parserOptions() []jwt.ParserOption {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(j.validMethods()),
+	}
+
+	if len(j.issuer) > 0 {
+		opts = append(opts, jwt.WithIssuer(j.issuer))
+	}
+
+	if len(j.audience) > 0 {
+		opts = append(opts, jwt.WithAudience(j.audience))
+	}
+
+	if j.leeway > 0 {
+		opts = append(opts, jwt.WithLeeway(j.leeway))
+	}
+
+	if slices.Contains(j.requiredClaims, "exp") {
+		opts = append(opts, jwt.WithExpirationRequired())
+	}
+
+	return opts
+}
+
+// checkRequiredClaims returns an error if claims is missing any of the required
+// claims or if the claims can't be checked.
+func (j *JWT) checkRequiredClaims(claims jwt.Claims) error {
+	if len(j.requiredClaims) == 0 {
+		return nil
+	}
+
+	mapClaims, ok := claims.(jwt.MapClaims)
+	if !ok {
+		return fmt.Errorf("%w: can't check required claims of %T", jwt.ErrTokenUnverifiable, claims)
+	}
+
+	for _, c := range j.requiredClaims {
+		if isEmptyClaim(mapClaims[c]) {
+			return fmt.Errorf("%w: %s", jwt.ErrTokenRequiredClaimMissing, c)
+		}
+	}
+
+	return nil
+}
+
+// isEmptyClaim reports whether the decoded claim value is missing or empty.
+func isEmptyClaim(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// tokenErrorDescriptions maps verification errors to descriptions
+// sent in the WWW-Authenticate header. Order matters since
+// claim errors wrap more than one error.
+var tokenErrorDescriptions = []struct {
+	err         error
+	description string
+}{
+	{jwt.ErrTokenMalformed, "The token is malformed"},
+	{jwt.ErrTokenSignatureInvalid, "The token signature is invalid"},
+	{jwt.ErrTokenUnverifiable, "The token could not be verified"},
+	{jwt.ErrTokenExpired, "The token has expired"},
+	{jwt.ErrTokenNotValidYet, "The token is not valid yet"},
+	{jwt.ErrTokenUsedBeforeIssued, "The token is used before issued"},
+	{jwt.ErrTokenInvalidIssuer, "The token issuer is invalid"},
+	{jwt.ErrTokenInvalidAudience, "The token audience is invalid"},
+	{jwt.ErrTokenRequiredClaimMissing, "The token is missing a required claim"},
+	{ErrMissingTokenID, "The token is missing a required claim"},
+	{ErrTokenRevoked, "The token has been revoked"},
+	{ErrNotAccessToken, "The token is not an access token"},
+}
+
+// tokenErrorDescription describes why token verification failed with err.
+func tokenErrorDescription(err error) string {
+	for _, d := range tokenErrorDescriptions {
+		if errors.Is(err, d.err) {
+			return d.description
+		}
+	}
+
+	return "The token is invalid"
+}
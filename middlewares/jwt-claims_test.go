@@ -0,0 +1,142 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWTRegisteredClaims(t *testing.T) {
+	j := middlewares.NewJWT(
+		middlewares.JWTWithSecret(jwtSecret),
+		middlewares.JWTWithIssuer("auth"),
+		middlewares.JWTWithAudience("api"),
+		middlewares.JWTWithRequiredClaims("exp", "sub"),
+	)
+
+	claims := func(modify func(*jwt.RegisteredClaims)) jwt.RegisteredClaims {
+		n := time.Now()
+		c := jwt.RegisteredClaims{
+			Issuer:    "auth",
+			Audience:  jwt.ClaimStrings{"api", "web"},
+			Subject:   "1",
+			ExpiresAt: jwt.NewNumericDate(n.Add(time.Minute)),
+		}
+		modify(&c)
+		return c
+	}
+
+	type testCase struct {
+		claims      jwt.RegisteredClaims
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{claims(func(c *jwt.RegisteredClaims) {}), nil},
+		{claims(func(c *jwt.RegisteredClaims) { c.Issuer = "other" }), jwt.ErrTokenInvalidIssuer},
+		{claims(func(c *jwt.RegisteredClaims) { c.Audience = jwt.ClaimStrings{"web"} }), jwt.ErrTokenInvalidAudience},
+		{claims(func(c *jwt.RegisteredClaims) { c.ExpiresAt = nil }), jwt.ErrTokenRequiredClaimMissing},
+		{claims(func(c *jwt.RegisteredClaims) { c.Subject = "" }), jwt.ErrTokenRequiredClaimMissing},
+		{
+			claims(func(c *jwt.RegisteredClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute)) }),
+			jwt.ErrTokenExpired,
+		},
+	}
+
+	for _, c := range testCases {
+		tokenStr, _ := j.Sign(jwt.SigningMethodHS256, c.claims)
+
+		_, err := j.Verify(tokenStr)
+		if c.expectedErr == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.ErrorIs(t, err, c.expectedErr)
+		}
+	}
+}
+
+func TestJWTRequiredClaimsEmpty(t *testing.T) {
+	j := middlewares.NewJWT(
+		middlewares.JWTWithSecret(jwtSecret),
+		middlewares.JWTWithRequiredClaims("sub", "roles"),
+	)
+
+	type testCase struct {
+		claims      jwt.MapClaims
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{jwt.MapClaims{"sub": "1", "roles": []string{"admin"}}, nil},
+		{jwt.MapClaims{"sub": "", "roles": []string{"admin"}}, jwt.ErrTokenRequiredClaimMissing},
+		{jwt.MapClaims{"sub": nil, "roles": []string{"admin"}}, jwt.ErrTokenRequiredClaimMissing},
+		{jwt.MapClaims{"sub": "1", "roles": []string{}}, jwt.ErrTokenRequiredClaimMissing},
+	}
+
+	for _, c := range testCases {
+		tokenStr, _ := j.Sign(jwt.SigningMethodHS256, c.claims)
+
+		_, err := j.Verify(tokenStr)
+		if c.expectedErr == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.ErrorIs(t, err, c.expectedErr)
+		}
+	}
+}
+
+func TestJWTWithLeeway(t *testing.T) {
+	expired := jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(-10 * time.Second))}
+
+	strict := middlewares.NewJWT(middlewares.JWTWithSecret(jwtSecret))
+	lenient := middlewares.NewJWT(middlewares.JWTWithSecret(jwtSecret), middlewares.JWTWithLeeway(time.Minute))
+
+	tokenStr, _ := strict.Sign(jwt.SigningMethodHS256, expired)
+
+	_, err := strict.Verify(tokenStr)
+	assert.ErrorIs(t, err, jwt.ErrTokenExpired)
+
+	_, err = lenient.Verify(tokenStr)
+	assert.Nil(t, err)
+}
+
+func TestAuthenticateErrorDescription(t *testing.T) {
+	middlewares.SetupJWT(middlewares.JWTWithSecret(jwtSecret), middlewares.JWTWithAudience("api"))
+	defer middlewares.SetupJWT(middlewares.JWTWithAudience(""))
+
+	h := middlewares.Authenticate()(http.HandlerFunc(okHandler))
+
+	type testCase struct {
+		claims              jwt.RegisteredClaims
+		expectedDescription string
+	}
+
+	testCases := []testCase{
+		{
+			jwt.RegisteredClaims{Audience: jwt.ClaimStrings{"api"}, ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+			`Bearer error="invalid_token", error_description="The token has expired"`,
+		},
+		{
+			jwt.RegisteredClaims{Audience: jwt.ClaimStrings{"web"}},
+			`Bearer error="invalid_token", error_description="The token audience is invalid"`,
+		},
+	}
+
+	for _, c := range testCases {
+		tokenStr, _ := middlewares.DefaultJWT.Sign(jwt.SigningMethodHS256, c.claims)
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Bearer "+tokenStr)
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, c.expectedDescription, w.Header().Get("WWW-Authenticate"))
+	}
+}
@@ -0,0 +1,173 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrInvalidAuthScheme is returned when the Authorization header
+	// doesn't use the Bearer scheme.
+	ErrInvalidAuthScheme = errors.New("invalid authorization scheme")
+
+	// ErrMalformedAuthHeader is returned when the Authorization header
+	// has more than one token after the scheme.
+	ErrMalformedAuthHeader = errors.New("malformed authorization header")
+)
+
+// TokenExtractor extracts the token from a request. It returns an empty
+// string if the request has no token and an error if the token is
+// present but malformed.
+type TokenExtractor func(*http.Request) (string, error)
+
+// BearerTokenExtractor extracts the token from the Authorization header
+// using the Bearer scheme. The scheme is case-insensitive.
+func BearerTokenExtractor() TokenExtractor {
+	return func(r *http.Request) (string, error) {
+		header := strings.TrimSpace(r.Header.Get("Authorization"))
+		if len(header) == 0 {
+			return "", nil
+		}
+
+		scheme, token, _ := strings.Cut(header, " ")
+		if !strings.EqualFold(scheme, "Bearer") {
+			return "", ErrInvalidAuthScheme
+		}
+
+		// no credentials at all, like a missing header
+		token = strings.TrimSpace(token)
+		if len(token) == 0 {
+			return "", nil
+		}
+
+		if strings.ContainsAny(token, " \t") {
+			return "", ErrMalformedAuthHeader
+		}
+
+		return token, nil
+	}
+}
+
+// HeaderTokenExtractor extracts the token from a custom header,
+// i.e X-Access-Token, which carries the token without any scheme.
+func HeaderTokenExtractor(name string) TokenExtractor {
+	return func(r *http.Request) (string, error) {
+		return strings.TrimSpace(r.Header.Get(name)), nil
+	}
+}
+
+// CookieTokenExtractor extracts the token from a cookie, i.e for
+// browser sessions.
+func CookieTokenExtractor(name string) TokenExtractor {
+	return func(r *http.Request) (string, error) {
+		c, err := r.Cookie(name)
+		if err != nil {
+			return "", nil
+		}
+
+		return c.Value, nil
+	}
+}
+
+// QueryTokenExtractor extracts the token from the first of the
+// URL search query keys having a value.
+func QueryTokenExtractor(keys ...string) TokenExtractor {
+	return func(r *http.Request) (string, error) {
+		query := r.URL.Query()
+		for _, k := range keys {
+			if t := query.Get(k); len(t) > 0 {
+				return t, nil
+			}
+		}
+
+		return "", nil
+	}
+}
+
+// ChainTokenExtractors tries the extractors in order and returns the
+// first token found. Errors don't stop the chain, i.e an Authorization
+// header of Basic scheme set by a proxy doesn't hide the session cookie.
+// The first error is returned only if no extractor finds a token.
+func ChainTokenExtractors(extractors ...TokenExtractor) TokenExtractor {
+	return func(r *http.Request) (string, error) {
+		var firstErr error
+		for _, e := range extractors {
+			token, err := e(r)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+
+			if len(token) > 0 {
+				return token, nil
+			}
+		}
+
+		return "", firstErr
+	}
+}
+
+// JWTWithTokenExtractors sets where the middleware looks for the token.
+// Extractors are tried in order, see ChainTokenExtractors. By default only
+// BearerTokenExtractor is used. Query keys passed to JWT.Middleware or
+// Authenticate are tried after these extractors.
+//
+// Example, Authorization header first and then the session cookie -
+//
+//	middlewares.JWTWithTokenExtractors(
+//		middlewares.BearerTokenExtractor(),
+//		middlewares.CookieTokenExtractor("session"),
+//	)
+func JWTWithTokenExtractors(extractors ...TokenExtractor) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.extractors = extractors
+		return j
+	}
+}
+
+// JWTWithRealm sets the realm of the WWW-Authenticate challenges.
+func JWTWithRealm(realm string) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.realm = realm
+		return j
+	}
+}
+
+// tokenExtractor returns the extractor chain used by the middleware.
+func (j *JWT) tokenExtractor(queryKeys []string) TokenExtractor {
+	extractors := j.extractors
+	if len(extractors) == 0 {
+		extractors = []TokenExtractor{BearerTokenExtractor()}
+	}
+
+	if len(queryKeys) > 0 {
+		extractors = append(extractors[:len(extractors):len(extractors)], QueryTokenExtractor(queryKeys...))
+	}
+
+	return ChainTokenExtractors(extractors...)
+}
+
+// challenge builds the WWW-Authenticate header value of the Bearer scheme
+// as defined in RFC 6750. code and description can be empty.
+func (j *JWT) challenge(code, description string) string {
+	params := []string{}
+	if len(j.realm) > 0 {
+		params = append(params, fmt.Sprintf("realm=%q", j.realm))
+	}
+	if len(code) > 0 {
+		params = append(params, fmt.Sprintf("error=%q", code))
+	}
+	if len(description) > 0 {
+		params = append(params, fmt.Sprintf("error_description=%q", description))
+	}
+
+	if len(params) == 0 {
+		return "Bearer"
+	}
+
+	return "Bearer " + strings.Join(params, ", ")
+}
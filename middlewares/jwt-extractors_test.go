@@ -0,0 +1,95 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenExtractors(t *testing.T) {
+	j := middlewares.NewJWT(
+		middlewares.JWTWithSecret(jwtSecret),
+		middlewares.JWTWithRealm("api"),
+		middlewares.JWTWithTokenExtractors(
+			middlewares.BearerTokenExtractor(),
+			middlewares.HeaderTokenExtractor("X-Access-Token"),
+			middlewares.CookieTokenExtractor("session"),
+		),
+	)
+	h := j.Middleware("token")(http.HandlerFunc(okHandler))
+
+	tokenStr, _ := j.Sign(jwt.SigningMethodHS256, dummyClaims())
+
+	type testCase struct {
+		name              string
+		setup             func(r *http.Request)
+		expectedStatus    int
+		expectedChallenge string
+	}
+
+	testCases := []testCase{
+		{"bearer", func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+tokenStr) }, http.StatusOK, ""},
+		{"lowercase scheme", func(r *http.Request) { r.Header.Set("Authorization", "bearer "+tokenStr) }, http.StatusOK, ""},
+		{"custom header", func(r *http.Request) { r.Header.Set("X-Access-Token", tokenStr) }, http.StatusOK, ""},
+		{"cookie", func(r *http.Request) { r.AddCookie(&http.Cookie{Name: "session", Value: tokenStr}) }, http.StatusOK, ""},
+		{"query", func(r *http.Request) { r.URL.RawQuery = "token=" + tokenStr }, http.StatusOK, ""},
+		{"no token", func(r *http.Request) {}, http.StatusUnauthorized, `Bearer realm="api"`},
+		{
+			"basic scheme",
+			func(r *http.Request) { r.Header.Set("Authorization", "Basic "+tokenStr) },
+			http.StatusBadRequest,
+			`Bearer realm="api", error="invalid_request", error_description="The authorization scheme must be Bearer"`,
+		},
+		{
+			"basic scheme with cookie",
+			func(r *http.Request) {
+				r.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+				r.AddCookie(&http.Cookie{Name: "session", Value: tokenStr})
+			},
+			http.StatusOK,
+			"",
+		},
+		{"missing token", func(r *http.Request) { r.Header.Set("Authorization", "Bearer") }, http.StatusUnauthorized, `Bearer realm="api"`},
+		{
+			"malformed token",
+			func(r *http.Request) { r.Header.Set("Authorization", "Bearer a b") },
+			http.StatusBadRequest,
+			`Bearer realm="api", error="invalid_request", error_description="The authorization header is malformed"`,
+		},
+		{
+			"invalid token",
+			func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+invalidTokenStr) },
+			http.StatusUnauthorized,
+			`Bearer realm="api", error="invalid_token", error_description="The token signature is invalid"`,
+		},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		c.setup(r)
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code, c.name)
+		assert.Equal(t, c.expectedChallenge, w.Header().Get("WWW-Authenticate"), c.name)
+	}
+}
+
+func TestAuthenticateChallenge(t *testing.T) {
+	middlewares.SetupJWT(middlewares.JWTWithSecret(jwtSecret))
+
+	h := middlewares.Authenticate()(http.HandlerFunc(okHandler))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+}
@@ -0,0 +1,222 @@
+package middlewares
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrInvalidPEM is returned when a PEM block can not be decoded.
+	ErrInvalidPEM = errors.New("invalid pem data")
+
+	// ErrUnsupportedKey is returned for key types not supported by JWT.
+	ErrUnsupportedKey = errors.New("unsupported key type")
+
+	// ErrNoVerificationKey is returned when there's no key
+	// configured to verify the token's signing method.
+	ErrNoVerificationKey = errors.New("no key to verify token")
+
+	// ErrNoSigningKey is returned when there's no key
+	// configured to sign with the signing method.
+	ErrNoSigningKey = errors.New("no key to sign token")
+)
+
+var (
+	hmacMethods    = []string{"HS256", "HS384", "HS512"}
+	rsaMethods     = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}
+	ecdsaMethods   = []string{"ES256", "ES384", "ES512"}
+	ed25519Methods = []string{"EdDSA"}
+)
+
+// ParsePublicKeyPEM parses a PEM encoded RSA, ECDSA or Ed25519 public key.
+// Both PKIX ("PUBLIC KEY") and PKCS #1 ("RSA PUBLIC KEY") blocks are supported.
+// Certificates are accepted too, their public key is returned.
+func ParsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := publicKeyMethods(key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// ParsePrivateKeyPEM parses a PEM encoded RSA, ECDSA or Ed25519 private key.
+// PKCS #8 ("PRIVATE KEY"), PKCS #1 ("RSA PRIVATE KEY") and SEC 1
+// ("EC PRIVATE KEY") blocks are supported.
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedKey
+	}
+
+	if _, err := publicKeyMethods(signer.Public()); err != nil {
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+// publicKeyMethods returns the signing methods which can be verified with key.
+func publicKeyMethods(key crypto.PublicKey) ([]string, error) {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return rsaMethods, nil
+	case *ecdsa.PublicKey:
+		return ecdsaMethods, nil
+	case ed25519.PublicKey:
+		return ed25519Methods, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+}
+
+// JWTWithPublicKey sets the public key used to verify RSA, RSA-PSS,
+// ECDSA or EdDSA signed tokens. See ParsePublicKeyPEM.
+func JWTWithPublicKey(key crypto.PublicKey) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.publicKey = key
+		return j
+	}
+}
+
+// JWTWithPrivateKey sets the private key used by JWT.Sign for RSA, RSA-PSS,
+// ECDSA or EdDSA signing methods. It's public key is used to verify tokens
+// unless one is set via JWTWithPublicKey. See ParsePrivateKeyPEM.
+func JWTWithPrivateKey(key crypto.Signer) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.privateKey = key
+		if j.publicKey == nil {
+			j.publicKey = key.Public()
+		}
+		return j
+	}
+}
+
+// JWTWithSigningMethods sets the signing methods, i.e "RS256" or "EdDSA",
+// accepted by JWT.Verify. Tokens having any other alg header are rejected.
+//
+// By default the methods are derived from the configured keys. HS256, HS384
+// and HS512 for secret, RS* and PS* for RSA keys, ES* for ECDSA keys and EdDSA
+// for Ed25519 keys. All of the asymmetric ones are accepted for key sets.
+func JWTWithSigningMethods(methods ...string) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.methods = slices.Clone(methods)
+		return j
+	}
+}
+
+// validMethods returns the signing methods accepted by Verify.
+func (j *JWT) validMethods() []string {
+	if len(j.methods) > 0 {
+		return j.methods
+	}
+
+	methods := []string{}
+	if len(j.secret) > 0 {
+		methods = append(methods, hmacMethods...)
+	}
+
+	if j.publicKey != nil {
+		if m, err := publicKeyMethods(j.publicKey); err == nil {
+			methods = append(methods, m...)
+		}
+	}
+
+	if j.keySet != nil {
+		methods = append(methods, rsaMethods...)
+		methods = append(methods, ecdsaMethods...)
+		methods = append(methods, ed25519Methods...)
+	}
+
+	return methods
+}
+
+// verificationKey returns the key to verify t.
+// Tokens having kid header are verified with the key set, if any.
+func (j *JWT) verificationKey(t *jwt.Token) (any, error) {
+	if kid, ok := t.Header["kid"].(string); ok && j.keySet != nil {
+		return j.keySet.Key(kid)
+	}
+
+	switch t.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(j.secret) > 0 {
+			return j.secret, nil
+		}
+	default:
+		if j.publicKey != nil {
+			return j.publicKey, nil
+		}
+	}
+
+	return nil, ErrNoVerificationKey
+}
+
+// signingKey returns the key to sign with method.
+func (j *JWT) signingKey(method jwt.SigningMethod) (any, error) {
+	switch method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(j.secret) > 0 {
+			return j.secret, nil
+		}
+	default:
+		if j.privateKey != nil {
+			return j.privateKey, nil
+		}
+	}
+
+	return nil, ErrNoSigningKey
+}
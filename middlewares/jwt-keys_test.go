@@ -0,0 +1,227 @@
+package middlewares_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func pemEncode(t *testing.T, typ string, key any) []byte {
+	var (
+		der []byte
+		err error
+	)
+	if typ == "PUBLIC KEY" {
+		der, err = x509.MarshalPKIXPublicKey(key)
+	} else {
+		der, err = x509.MarshalPKCS8PrivateKey(key)
+	}
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+}
+
+func TestAsymmetricJWT(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	type testCase struct {
+		key    crypto.Signer
+		method jwt.SigningMethod
+	}
+
+	testCases := []testCase{
+		{rsaKey, jwt.SigningMethodRS256},
+		{rsaKey, jwt.SigningMethodPS384},
+		{ecKey, jwt.SigningMethodES256},
+		{edKey, jwt.SigningMethodEdDSA},
+	}
+
+	for _, c := range testCases {
+		private, err := middlewares.ParsePrivateKeyPEM(pemEncode(t, "PRIVATE KEY", c.key))
+		assert.Nil(t, err)
+
+		public, err := middlewares.ParsePublicKeyPEM(pemEncode(t, "PUBLIC KEY", c.key.Public()))
+		assert.Nil(t, err)
+
+		signer := middlewares.NewJWT(middlewares.JWTWithPrivateKey(private))
+		verifier := middlewares.NewJWT(middlewares.JWTWithPublicKey(public))
+
+		tokenStr, err := signer.Sign(c.method, dummyClaims())
+		assert.Nil(t, err, c.method.Alg())
+
+		token, err := verifier.Verify(tokenStr)
+		assert.Nil(t, err, c.method.Alg())
+		assert.Equal(t, c.method.Alg(), token.Method.Alg())
+
+		// HMAC tokens are not signable nor verifiable without secret
+		_, err = signer.Sign(jwt.SigningMethodHS256, dummyClaims())
+		assert.ErrorIs(t, err, middlewares.ErrNoSigningKey)
+	}
+}
+
+func TestJWTAlgorithmConfusion(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	publicPEM := pemEncode(t, "PUBLIC KEY", rsaKey.Public())
+
+	public, _ := middlewares.ParsePublicKeyPEM(publicPEM)
+	verifier := middlewares.NewJWT(middlewares.JWTWithPublicKey(public))
+
+	// HS256 token signed with the public key as secret
+	tokenStr, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, dummyClaims()).SignedString(publicPEM)
+
+	_, err := verifier.Verify(tokenStr)
+	assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+
+	// unsigned token
+	tokenStr, _ = jwt.NewWithClaims(jwt.SigningMethodNone, dummyClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	_, err = verifier.Verify(tokenStr)
+	assert.NotNil(t, err)
+}
+
+func TestJWTSigningMethods(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	j := middlewares.NewJWT(
+		middlewares.JWTWithPrivateKey(rsaKey),
+		middlewares.JWTWithSigningMethods("RS256"),
+	)
+
+	tokenStr, _ := j.Sign(jwt.SigningMethodRS256, dummyClaims())
+	_, err := j.Verify(tokenStr)
+	assert.Nil(t, err)
+
+	tokenStr, _ = j.Sign(jwt.SigningMethodRS512, dummyClaims())
+	_, err = j.Verify(tokenStr)
+	assert.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+}
+
+func jwksDocument(keys map[string]crypto.PublicKey) []byte {
+	enc := func(b []byte) string {
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	doc := map[string][]map[string]string{"keys": {}}
+	for kid, key := range keys {
+		switch k := key.(type) {
+		case *rsa.PublicKey:
+			doc["keys"] = append(doc["keys"], map[string]string{
+				"kty": "RSA", "kid": kid, "n": enc(k.N.Bytes()), "e": enc(big.NewInt(int64(k.E)).Bytes()),
+			})
+		case *ecdsa.PublicKey:
+			doc["keys"] = append(doc["keys"], map[string]string{
+				"kty": "EC", "kid": kid, "crv": "P-256", "x": enc(k.X.Bytes()), "y": enc(k.Y.Bytes()),
+			})
+		case ed25519.PublicKey:
+			doc["keys"] = append(doc["keys"], map[string]string{
+				"kty": "OKP", "kid": kid, "crv": "Ed25519", "x": enc(k),
+			})
+		}
+	}
+	doc["keys"] = append(doc["keys"], map[string]string{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"})
+
+	data, _ := json.Marshal(doc)
+	return data
+}
+
+func signWithKid(key crypto.Signer, method jwt.SigningMethod, kid string) string {
+	token := jwt.NewWithClaims(method, dummyClaims())
+	token.Header["kid"] = kid
+	s, _ := token.SignedString(key)
+	return s
+}
+
+func TestJWKSFromFile(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPublic, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	name := filepath.Join(t.TempDir(), "jwks.json")
+	os.WriteFile(name, jwksDocument(map[string]crypto.PublicKey{
+		"rsa": rsaKey.Public(),
+		"ec":  ecKey.Public(),
+		"ed":  edPublic,
+	}), 0o600)
+
+	ks, err := middlewares.NewJWKSFromFile(name)
+	assert.Nil(t, err)
+
+	j := middlewares.NewJWT(middlewares.JWTWithKeySet(ks))
+
+	for kid, tokenStr := range map[string]string{
+		"rsa": signWithKid(rsaKey, jwt.SigningMethodRS256, "rsa"),
+		"ec":  signWithKid(ecKey, jwt.SigningMethodES256, "ec"),
+		"ed":  signWithKid(edKey, jwt.SigningMethodEdDSA, "ed"),
+	} {
+		_, err := j.Verify(tokenStr)
+		assert.Nil(t, err, kid)
+	}
+
+	// signed with a key not matching the kid
+	_, err = j.Verify(signWithKid(ecKey, jwt.SigningMethodES256, "rsa"))
+	assert.NotNil(t, err)
+
+	_, err = j.Verify(signWithKid(rsaKey, jwt.SigningMethodRS256, "missing"))
+	assert.ErrorIs(t, err, middlewares.ErrUnknownKeyID)
+}
+
+func TestJWKSFromURL(t *testing.T) {
+	oldKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	newKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	var (
+		rotated atomic.Bool
+		fetches atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		keys := map[string]crypto.PublicKey{"old": oldKey.Public()}
+		if rotated.Load() {
+			keys["new"] = newKey.Public()
+		}
+		w.Write(jwksDocument(keys))
+	}))
+	defer srv.Close()
+
+	ks, err := middlewares.NewJWKSFromURL(srv.URL, 10*time.Millisecond)
+	assert.Nil(t, err)
+
+	j := middlewares.NewJWT(middlewares.JWTWithKeySet(ks))
+
+	_, err = j.Verify(signWithKid(oldKey, jwt.SigningMethodES256, "old"))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	rotated.Store(true)
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = j.Verify(signWithKid(newKey, jwt.SigningMethodES256, "new"))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	failing := httptest.NewServer(http.NotFoundHandler())
+	defer failing.Close()
+
+	_, err = middlewares.NewJWKSFromURL(failing.URL, 0)
+	assert.NotNil(t, err)
+}
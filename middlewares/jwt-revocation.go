@@ -0,0 +1,220 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrTokenRevoked is returned for tokens revoked via JWT.Revoke
+	// or revoked along with their family on refresh token reuse.
+	ErrTokenRevoked = errors.New("token is revoked")
+
+	// ErrMissingTokenID is returned for tokens without jti claim
+	// when a RevocationStore is set.
+	ErrMissingTokenID = errors.New("token has no jti claim")
+
+	// ErrNoRevocationStore is returned when revoking or rotating
+	// tokens without a RevocationStore.
+	ErrNoRevocationStore = errors.New("no revocation store")
+)
+
+// familyKeyPrefix prefixes token family ids in RevocationStore.
+const familyKeyPrefix = "family:"
+
+// RevocationStore keeps track of revoked token ids until they expire.
+// Implementations must be safe for concurrent use.
+type RevocationStore interface {
+	// Revoke revokes id until expiresAt. It reports if id was already
+	// revoked, which must be checked and set atomically, i.e SET NX in Redis,
+	// since refresh token reuse detection relies on it.
+	Revoke(ctx context.Context, id string, expiresAt time.Time) (bool, error)
+
+	// IsRevoked reports if id is revoked.
+	IsRevoked(ctx context.Context, id string) (bool, error)
+}
+
+// MemoryRevocationStore is an in-memory RevocationStore.
+// Entries are removed after they expire.
+type MemoryRevocationStore struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+	sweepAt time.Time
+}
+
+// sweepInterval is the minimum interval between removing expired entries.
+const sweepInterval = time.Minute
+
+// NewMemoryRevocationStore creates a new MemoryRevocationStore.
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{
+		revoked: map[string]time.Time{},
+	}
+}
+
+// Revoke implements RevocationStore.
+func (s *MemoryRevocationStore) Revoke(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	if exp, ok := s.revoked[id]; ok && exp.After(now) {
+		if expiresAt.After(exp) {
+			s.revoked[id] = expiresAt
+		}
+		return true, nil
+	}
+
+	s.revoked[id] = expiresAt
+	return false, nil
+}
+
+// IsRevoked implements RevocationStore.
+func (s *MemoryRevocationStore) IsRevoked(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exp, ok := s.revoked[id]
+	return ok && exp.After(time.Now()), nil
+}
+
+// sweep removes expired entries, at most once per sweepInterval.
+func (s *MemoryRevocationStore) sweep(now time.Time) {
+	if now.Before(s.sweepAt) {
+		return
+	}
+
+	for id, exp := range s.revoked {
+		if !exp.After(now) {
+			delete(s.revoked, id)
+		}
+	}
+	s.sweepAt = now.Add(sweepInterval)
+}
+
+// JWTWithRevocationStore sets the store used to check revoked tokens.
+// Verified tokens must then have a jti claim which is not revoked, nor
+// it's family in case of tokens issued via JWT.IssueTokenPair.
+func JWTWithRevocationStore(store RevocationStore) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.revocations = store
+		return j
+	}
+}
+
+// checkRevoked returns ErrTokenRevoked if the token or it's family is revoked.
+func (j *JWT) checkRevoked(ctx context.Context, claims jwt.MapClaims) error {
+	if j.revocations == nil {
+		return nil
+	}
+
+	jti, _ := claims["jti"].(string)
+	if len(jti) == 0 {
+		return ErrMissingTokenID
+	}
+
+	ids := []string{jti}
+	if fam, _ := claims[familyClaim].(string); len(fam) > 0 {
+		ids = append(ids, familyKeyPrefix+fam)
+	}
+
+	for _, id := range ids {
+		revoked, err := j.revocations.IsRevoked(ctx, id)
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return ErrTokenRevoked
+		}
+	}
+
+	return nil
+}
+
+// Revoke verifies tokenStr and revokes it until it expires, i.e on logout.
+// Tokens issued via IssueTokenPair are revoked along with their family,
+// so the access and refresh tokens of the session stop working together.
+//
+// Expired tokens can be revoked too, as logging out with an expired access
+// token must still revoke its refresh token. Their signature, issuer,
+// audience and required claims are verified as usual.
+func (j *JWT) Revoke(ctx context.Context, tokenStr string) error {
+	if j.revocations == nil {
+		return ErrNoRevocationStore
+	}
+
+	claims, err := j.verifyRevocable(tokenStr)
+	if err != nil {
+		return err
+	}
+
+	jti, _ := claims["jti"].(string)
+	if len(jti) == 0 {
+		return ErrMissingTokenID
+	}
+
+	exp := tokenExpiry(claims, j.refreshLifetime())
+	if _, err := j.revocations.Revoke(ctx, jti, exp); err != nil {
+		return err
+	}
+
+	if fam, _ := claims[familyClaim].(string); len(fam) > 0 {
+		if _, err := j.revocations.Revoke(ctx, familyKeyPrefix+fam, time.Now().Add(j.refreshLifetime())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyRevocable verifies tokenStr like Verify, except the time based
+// claims, and returns its claims.
+func (j *JWT) verifyRevocable(tokenStr string) (jwt.MapClaims, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods(j.validMethods()), jwt.WithoutClaimsValidation())
+	token, err := parser.Parse(tokenStr, j.verificationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	if len(j.issuer) > 0 {
+		if iss, _ := claims.GetIssuer(); iss != j.issuer {
+			return nil, fmt.Errorf("%w: %w", jwt.ErrTokenInvalidClaims, jwt.ErrTokenInvalidIssuer)
+		}
+	}
+
+	if len(j.audience) > 0 {
+		if aud, _ := claims.GetAudience(); !slices.Contains(aud, j.audience) {
+			return nil, fmt.Errorf("%w: %w", jwt.ErrTokenInvalidClaims, jwt.ErrTokenInvalidAudience)
+		}
+	}
+
+	if err := j.checkRequiredClaims(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// tokenExpiry returns the exp claim or now + fallback if there's none.
+func tokenExpiry(claims jwt.MapClaims, fallback time.Duration) time.Time {
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		return exp.Time
+	}
+
+	return time.Now().Add(fallback)
+}
+
+var _ = (RevocationStore)(&MemoryRevocationStore{})
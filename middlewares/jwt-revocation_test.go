@@ -0,0 +1,175 @@
+package middlewares_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryRevocationStore(t *testing.T) {
+	ctx := context.Background()
+	s := middlewares.NewMemoryRevocationStore()
+
+	revoked, _ := s.IsRevoked(ctx, "a")
+	assert.False(t, revoked)
+
+	already, _ := s.Revoke(ctx, "a", time.Now().Add(time.Minute))
+	assert.False(t, already)
+
+	already, _ = s.Revoke(ctx, "a", time.Now().Add(time.Minute))
+	assert.True(t, already)
+
+	revoked, _ = s.IsRevoked(ctx, "a")
+	assert.True(t, revoked)
+
+	s.Revoke(ctx, "b", time.Now().Add(-time.Second))
+	revoked, _ = s.IsRevoked(ctx, "b")
+	assert.False(t, revoked)
+}
+
+func TestJWTRevocation(t *testing.T) {
+	ctx := context.Background()
+	j := middlewares.NewJWT(
+		middlewares.JWTWithSecret(jwtSecret),
+		middlewares.JWTWithRevocationStore(middlewares.NewMemoryRevocationStore()),
+	)
+	h := j.Middleware()(http.HandlerFunc(okHandler))
+
+	authenticate := func(tokenStr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Bearer "+tokenStr)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	pair, err := j.IssueTokenPair(jwt.SigningMethodHS256, "1", map[string]any{"UserType": "Admin"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(middlewares.DefaultAccessTokenTTL.Seconds()), pair.ExpiresIn)
+
+	assert.Equal(t, http.StatusOK, authenticate(pair.AccessToken).Code)
+
+	// refresh tokens are not accepted as access tokens
+	w := authenticate(pair.RefreshToken)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, `Bearer error="invalid_token", error_description="The token is not an access token"`, w.Header().Get("WWW-Authenticate"))
+
+	// tokens without jti are rejected
+	noID, _ := j.Sign(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "1"})
+	assert.Equal(t, http.StatusUnauthorized, authenticate(noID).Code)
+
+	// logout revokes the session
+	assert.Nil(t, j.Revoke(ctx, pair.AccessToken))
+
+	w = authenticate(pair.AccessToken)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, `Bearer error="invalid_token", error_description="The token has been revoked"`, w.Header().Get("WWW-Authenticate"))
+
+	_, err = j.Rotate(ctx, jwt.SigningMethodHS256, pair.RefreshToken, nil)
+	assert.ErrorIs(t, err, middlewares.ErrTokenRevoked)
+}
+
+func TestJWTVerifyContext(t *testing.T) {
+	ctx := context.Background()
+	j := middlewares.NewJWT(
+		middlewares.JWTWithSecret(jwtSecret),
+		middlewares.JWTWithRevocationStore(middlewares.NewMemoryRevocationStore()),
+	)
+
+	pair, _ := j.IssueTokenPair(jwt.SigningMethodHS256, "1", nil)
+
+	_, err := j.VerifyContext(ctx, pair.AccessToken)
+	assert.Nil(t, err)
+
+	_, err = j.VerifyContext(ctx, pair.RefreshToken)
+	assert.ErrorIs(t, err, middlewares.ErrNotAccessToken)
+
+	assert.Nil(t, j.Revoke(ctx, pair.AccessToken))
+
+	_, err = j.VerifyContext(ctx, pair.AccessToken)
+	assert.ErrorIs(t, err, middlewares.ErrTokenRevoked)
+
+	// Verify checks neither
+	_, err = j.Verify(pair.AccessToken)
+	assert.Nil(t, err)
+}
+
+func TestJWTRevokeExpired(t *testing.T) {
+	ctx := context.Background()
+	j := middlewares.NewJWT(
+		middlewares.JWTWithSecret(jwtSecret),
+		middlewares.JWTWithIssuer("auth"),
+		middlewares.JWTWithRevocationStore(middlewares.NewMemoryRevocationStore()),
+	)
+
+	pair, _ := j.IssueTokenPair(jwt.SigningMethodHS256, "1", nil)
+	refresh, _ := j.Verify(pair.RefreshToken)
+	family := refresh.Claims.(jwt.MapClaims)["fam"]
+
+	expired := func(iss string) string {
+		tokenStr, _ := j.Sign(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": "1",
+			"jti": "expired",
+			"iss": iss,
+			"exp": time.Now().Add(-time.Hour).Unix(),
+			"typ": "access",
+			"fam": family,
+		})
+		return tokenStr
+	}
+
+	// the issuer is still checked
+	assert.ErrorIs(t, j.Revoke(ctx, expired("other")), jwt.ErrTokenInvalidIssuer)
+
+	// logout after the access token expired revokes the session
+	assert.Nil(t, j.Revoke(ctx, expired("auth")))
+
+	_, err := j.Rotate(ctx, jwt.SigningMethodHS256, pair.RefreshToken, nil)
+	assert.ErrorIs(t, err, middlewares.ErrTokenRevoked)
+}
+
+func TestJWTRotate(t *testing.T) {
+	ctx := context.Background()
+	j := middlewares.NewJWT(
+		middlewares.JWTWithSecret(jwtSecret),
+		middlewares.JWTWithRevocationStore(middlewares.NewMemoryRevocationStore()),
+		middlewares.JWTWithTokenTTL(time.Minute, time.Hour),
+	)
+
+	first, _ := j.IssueTokenPair(jwt.SigningMethodHS256, "1", nil)
+
+	_, err := j.Rotate(ctx, jwt.SigningMethodHS256, first.AccessToken, nil)
+	assert.ErrorIs(t, err, middlewares.ErrNotRefreshToken)
+
+	second, err := j.Rotate(ctx, jwt.SigningMethodHS256, first.RefreshToken, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(60), second.ExpiresIn)
+
+	token, err := j.Verify(second.AccessToken)
+	assert.Nil(t, err)
+	subject, _ := token.Claims.GetSubject()
+	assert.Equal(t, "1", subject)
+
+	// reusing the rotated refresh token revokes the family
+	_, err = j.Rotate(ctx, jwt.SigningMethodHS256, first.RefreshToken, nil)
+	assert.ErrorIs(t, err, middlewares.ErrRefreshTokenReused)
+
+	_, err = j.Rotate(ctx, jwt.SigningMethodHS256, second.RefreshToken, nil)
+	assert.ErrorIs(t, err, middlewares.ErrTokenRevoked)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+second.AccessToken)
+	w := httptest.NewRecorder()
+	j.Middleware()(http.HandlerFunc(okHandler)).ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// rotation needs a revocation store
+	_, err = middlewares.NewJWT(middlewares.JWTWithSecret(jwtSecret)).Rotate(ctx, jwt.SigningMethodHS256, first.RefreshToken, nil)
+	assert.ErrorIs(t, err, middlewares.ErrNoRevocationStore)
+}
@@ -0,0 +1,193 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"maps"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// DefaultAccessTokenTTL is the default lifetime of access tokens.
+	DefaultAccessTokenTTL = 15 * time.Minute
+
+	// DefaultRefreshTokenTTL is the default lifetime of refresh tokens.
+	DefaultRefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// claims set by IssueTokenPair
+const (
+	typeClaim    = "typ"
+	familyClaim  = "fam"
+	accessToken  = "access"
+	refreshToken = "refresh"
+)
+
+var (
+	// ErrNotRefreshToken is returned when rotating a token which is
+	// not a refresh token issued via JWT.IssueTokenPair.
+	ErrNotRefreshToken = errors.New("token is not a refresh token")
+
+	// ErrNotAccessToken is returned by JWT.Middleware for refresh tokens.
+	ErrNotAccessToken = errors.New("token is not an access token")
+
+	// ErrRefreshTokenReused is returned when an already rotated refresh
+	// token is used again. The whole token family is revoked in that case.
+	ErrRefreshTokenReused = errors.New("refresh token is reused")
+)
+
+// TokenPair is an access and refresh token pair issued via JWT.IssueTokenPair.
+type TokenPair struct {
+	// AccessToken is the short lived token for Authorization header.
+	AccessToken string `json:"accessToken"`
+
+	// RefreshToken is the long lived token to get a new pair via JWT.Rotate.
+	RefreshToken string `json:"refreshToken"`
+
+	// ExpiresIn is the lifetime of the access token in seconds.
+	ExpiresIn int64 `json:"expiresIn"`
+}
+
+// JWTWithTokenTTL sets the lifetimes of access and refresh tokens issued
+// via JWT.IssueTokenPair. Non-positive values keep the defaults,
+// DefaultAccessTokenTTL and DefaultRefreshTokenTTL.
+func JWTWithTokenTTL(access, refresh time.Duration) JWTSetupFunc {
+	return func(j *JWT) *JWT {
+		j.accessTTL = access
+		j.refreshTTL = refresh
+		return j
+	}
+}
+
+// accessLifetime returns the lifetime of access tokens.
+func (j *JWT) accessLifetime() time.Duration {
+	if j.accessTTL > 0 {
+		return j.accessTTL
+	}
+	return DefaultAccessTokenTTL
+}
+
+// refreshLifetime returns the lifetime of refresh tokens.
+func (j *JWT) refreshLifetime() time.Duration {
+	if j.refreshTTL > 0 {
+		return j.refreshTTL
+	}
+	return DefaultRefreshTokenTTL
+}
+
+// IssueTokenPair signs a new access and refresh token pair for subject.
+// claims are added to the access token, i.e the fields of the payload type.
+//
+// Both tokens have a random jti and share a family id in the fam claim,
+// which is kept across rotations. The typ claim tells them apart, refresh
+// tokens are rejected by JWT.Middleware.
+func (j *JWT) IssueTokenPair(method jwt.SigningMethod, subject string, claims map[string]any) (*TokenPair, error) {
+	return j.issueTokenPair(method, subject, rand.Text(), claims)
+}
+
+// issueTokenPair signs a new token pair of the family.
+func (j *JWT) issueTokenPair(method jwt.SigningMethod, subject, family string, claims map[string]any) (*TokenPair, error) {
+	now := time.Now()
+
+	registered := func(typ string, ttl time.Duration) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"sub":       subject,
+			"jti":       rand.Text(),
+			"iat":       now.Unix(),
+			"exp":       now.Add(ttl).Unix(),
+			typeClaim:   typ,
+			familyClaim: family,
+		}
+		if len(j.issuer) > 0 {
+			c["iss"] = j.issuer
+		}
+		if len(j.audience) > 0 {
+			c["aud"] = j.audience
+		}
+		return c
+	}
+
+	access := jwt.MapClaims{}
+	maps.Copy(access, claims)
+	maps.Copy(access, registered(accessToken, j.accessLifetime()))
+
+	accessStr, err := j.Sign(method, access)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshStr, err := j.Sign(method, registered(refreshToken, j.refreshLifetime()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessStr,
+		RefreshToken: refreshStr,
+		ExpiresIn:    int64(j.accessLifetime().Seconds()),
+	}, nil
+}
+
+// Rotate exchanges a refresh token for a new token pair of the same family
+// and subject, revoking the used refresh token. It requires a RevocationStore.
+//
+// Using a refresh token more than once means it has leaked, so the whole
+// family is revoked, including the access and refresh tokens issued to
+// the legitimate client, and ErrRefreshTokenReused is returned.
+func (j *JWT) Rotate(ctx context.Context, method jwt.SigningMethod, refreshTokenStr string, claims map[string]any) (*TokenPair, error) {
+	if j.revocations == nil {
+		return nil, ErrNoRevocationStore
+	}
+
+	c, err := j.verifyClaims(refreshTokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, _ := c[typeClaim].(string)
+	family, _ := c[familyClaim].(string)
+	jti, _ := c["jti"].(string)
+	if typ != refreshToken || len(family) == 0 || len(jti) == 0 {
+		return nil, ErrNotRefreshToken
+	}
+
+	familyKey := familyKeyPrefix + family
+	if revoked, err := j.revocations.IsRevoked(ctx, familyKey); err != nil {
+		return nil, err
+	} else if revoked {
+		return nil, ErrTokenRevoked
+	}
+
+	reused, err := j.revocations.Revoke(ctx, jti, tokenExpiry(c, j.refreshLifetime()))
+	if err != nil {
+		return nil, err
+	}
+
+	if reused {
+		if _, err := j.revocations.Revoke(ctx, familyKey, time.Now().Add(j.refreshLifetime())); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	subject, _ := c.GetSubject()
+	return j.issueTokenPair(method, subject, family, claims)
+}
+
+// verifyClaims verifies tokenStr and returns it's claims.
+func (j *JWT) verifyClaims(tokenStr string) (jwt.MapClaims, error) {
+	token, err := j.Verify(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	return claims, nil
+}
@@ -2,10 +2,12 @@ package middlewares
 
 import (
 	"context"
+	"crypto"
 	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	gohttputil "github.com/asif-mahmud/go-httputil"
 	"github.com/asif-mahmud/go-httputil/helpers"
@@ -17,23 +19,59 @@ import (
 // JWT provides interface for setting up JWT token parsing and payload retrieval.
 type JWT struct {
 	secret      []byte
+	publicKey   crypto.PublicKey
+	privateKey  crypto.Signer
+	keySet      KeySet
+	methods     []string
 	payloadType any
+
+	issuer         string
+	audience       string
+	leeway         time.Duration
+	requiredClaims []string
+
+	revocations RevocationStore
+	accessTTL   time.Duration
+	refreshTTL  time.Duration
+
+	extractors []TokenExtractor
+	realm      string
 }
 
-// Sign creates a JWT using the secret key set in setup stage.
+// Sign creates a JWT using the key set in setup stage. HMAC methods
+// use the secret key and the others use the private key.
 func (j *JWT) Sign(method jwt.SigningMethod, payload jwt.Claims) (string, error) {
+	key, err := j.signingKey(method)
+	if err != nil {
+		return "", err
+	}
+
 	token := jwt.NewWithClaims(method, payload)
-	return token.SignedString([]byte(j.secret))
+	return token.SignedString(key)
 }
 
+// Verify parses tokenStr and verifies it's signature and claims.
+// Only the signing methods set via JWTWithSigningMethods, or derived
+// from the configured keys, are accepted. Registered claims are checked
+// against JWTWithIssuer, JWTWithAudience, JWTWithLeeway and JWTWithRequiredClaims.
+//
+// The returned error wraps the golang-jwt errors, i.e jwt.ErrTokenExpired
+// or jwt.ErrTokenInvalidAudience.
+//
+// Verify doesn't check the RevocationStore nor rejects refresh tokens
+// issued via IssueTokenPair. Use VerifyContext to verify access tokens
+// outside of JWT.Middleware, i.e for websockets.
 func (j *JWT) Verify(tokenStr string) (*jwt.Token, error) {
-	token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
-		return []byte(j.secret), nil
-	})
+	parser := jwt.NewParser(j.parserOptions()...)
+	token, err := parser.Parse(tokenStr, j.verificationKey)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := j.checkRequiredClaims(token.Claims); err != nil {
+		return nil, err
+	}
+
 	if !token.Valid {
 		return nil, errors.New("invalid token")
 	}
@@ -41,7 +79,37 @@ func (j *JWT) Verify(tokenStr string) (*jwt.Token, error) {
 	return token, nil
 }
 
+// VerifyContext verifies tokenStr like Verify and additionally rejects
+// refresh tokens and the tokens revoked in the RevocationStore set via
+// JWTWithRevocationStore, exactly like JWT.Middleware does.
+func (j *JWT) VerifyContext(ctx context.Context, tokenStr string) (*jwt.Token, error) {
+	token, err := j.Verify(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := j.checkToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// NewJWT creates a new JWT instance set up by setupFuncs.
+// Unlike DefaultJWT it's independent of the global state, so
+// several instances with different keys and claims can be used
+// side by side via JWT.Middleware.
+func NewJWT(setupFuncs ...JWTSetupFunc) *JWT {
+	j := &JWT{}
+	for _, f := range setupFuncs {
+		j = f(j)
+	}
+
+	return j
+}
+
 // DefaultJWT is global JWT instance.
+// It's used by Authenticate and set up via SetupJWT.
 var DefaultJWT = &JWT{}
 
 // JWTSetupFunc is the signature for setting up JWT via builder function.
@@ -73,84 +141,143 @@ func JWTWithPayloadType(t any) JWTSetupFunc {
 	}
 }
 
-// jwtPayloadKey is the request context key
-const jwtPayloadKey = "_jwtPayload"
-
 // unauthorizedResponse sends unauthorized response
-func unauthorizedResponse(w http.ResponseWriter) {
-	helpers.SendError(w, http.StatusUnauthorized, "Unauthorized", nil)
+func unauthorizedResponse(w http.ResponseWriter, r *http.Request) {
+	helpers.RespondError(w, r, http.StatusUnauthorized, "Unauthorized", nil)
+}
+
+// challengeResponse sends unauthorized response with a Bearer
+// challenge in the WWW-Authenticate header.
+func (j *JWT) challengeResponse(w http.ResponseWriter, r *http.Request, code, description string) {
+	w.Header().Set("WWW-Authenticate", j.challenge(code, description))
+	unauthorizedResponse(w, r)
 }
 
-// Authenticate creates a middleware to verify and parse jwt.
-// By default  it will checj Bearer token from Authorization header.
+// invalidRequestResponse sends bad request response with a Bearer
+// challenge for requests having a malformed Authorization header,
+// as required by RFC 6750 for the invalid_request error.
+func (j *JWT) invalidRequestResponse(w http.ResponseWriter, r *http.Request, err error) {
+	description := "The authorization header is malformed"
+	if errors.Is(err, ErrInvalidAuthScheme) {
+		description = "The authorization scheme must be Bearer"
+	}
+
+	w.Header().Set("WWW-Authenticate", j.challenge("invalid_request", description))
+	badrequest(w, r, "Invalid request", nil)
+}
+
+// invalidTokenResponse logs the token verification error and sends
+// unauthorized response describing it in the WWW-Authenticate header.
+func (j *JWT) invalidTokenResponse(w http.ResponseWriter, r *http.Request, err error) {
+	description := tokenErrorDescription(err)
+	slog.Warn("JWT verification failed", golog.Extra(map[string]any{
+		"reason": description,
+		"error":  err.Error(),
+	}))
+
+	j.challengeResponse(w, r, "invalid_token", description)
+}
+
+// Middleware creates a middleware to verify and parse jwt using this JWT.
+// By default it will check Bearer token from Authorization header.
+// Other sources can be set via JWTWithTokenExtractors.
 // But user may specify URL search query keys in queryKeys parameter
 // from which jwt can be collected, verified and parsed.
 // If authentication fails an unauthorized response will be sent to
-// the client.
+// the client along with a Bearer challenge in WWW-Authenticate header.
 // If authentication succeeds request's context key will contain
 // JWT payload if payload type is specified in setup stage.
+//
+// Different JWT instances can be attached to different groups, i.e
+// one accepting user tokens and another accepting service tokens.
+func (j *JWT) Middleware(queryKeys ...string) gohttputil.Middleware {
+	m := func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			j.authenticate(w, r, next, queryKeys)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+
+	return m
+}
+
+// authenticate verifies the token of r and calls next with
+// the JWT payload stored in the request context.
+func (j *JWT) authenticate(w http.ResponseWriter, r *http.Request, next http.Handler, queryKeys []string) {
+	// collect token
+	tokenStr, err := j.tokenExtractor(queryKeys)(r)
+	if err != nil {
+		j.invalidRequestResponse(w, r, err)
+		return
+	}
+
+	if len(tokenStr) == 0 {
+		j.challengeResponse(w, r, "", "")
+		return
+	}
+
+	// parse and verify jwt
+	token, err := j.VerifyContext(r.Context(), tokenStr)
+	if err != nil {
+		j.invalidTokenResponse(w, r, err)
+		return
+	}
+
+	if j.payloadType == nil {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	// parse and set jwt payload in the context
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		slog.Error("Failed to parse JWT payload")
+		unauthorizedResponse(w, r)
+		return
+	}
+
+	p, err := helpers.NewValue(j.payloadType)
+	if err != nil {
+		slog.Error("Failed to initiate JWT payload type", golog.Extra(map[string]any{
+			"error": err.Error(),
+		}))
+		unauthorizedResponse(w, r)
+		return
+	}
+	pi := p.Interface()
+	if err := mapstructure.Decode(claims, pi); err != nil {
+		slog.Error("Failed to decode payload type", golog.Extra(map[string]any{
+			"error": err.Error(),
+		}))
+		unauthorizedResponse(w, r)
+		return
+	}
+	wrappedRequest := r.WithContext(context.WithValue(r.Context(), jwtPayloadKey, pi))
+	next.ServeHTTP(w, wrappedRequest)
+}
+
+// checkToken rejects refresh tokens and revoked tokens.
+func (j *JWT) checkToken(ctx context.Context, token *jwt.Token) error {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil
+	}
+
+	if typ, _ := claims[typeClaim].(string); typ == refreshToken {
+		return ErrNotAccessToken
+	}
+
+	return j.checkRevoked(ctx, claims)
+}
+
+// Authenticate creates a middleware to verify and parse jwt using DefaultJWT.
+// DefaultJWT is read on every request, so it can be set up via SetupJWT
+// after creating the middleware. See JWT.Middleware for details.
 func Authenticate(queryKeys ...string) gohttputil.Middleware {
 	m := func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
-			// collect token from header
-			header := r.Header.Get("authorization")
-			tokenStr := ""
-
-			// collect token from query if needed
-			if len(header) == 0 && len(queryKeys) > 0 {
-				for _, k := range queryKeys {
-					if t := r.URL.Query().Get(k); len(t) > 0 {
-						tokenStr = t
-						break
-					}
-				}
-			} else {
-				tokens := strings.Split(header, " ")
-				if len(tokens) != 2 {
-					unauthorizedResponse(w)
-					return
-				}
-				tokenStr = tokens[1]
-			}
-
-			// parse and verify jwt
-			token, err := DefaultJWT.Verify(tokenStr)
-			if err != nil {
-				unauthorizedResponse(w)
-				return
-			}
-
-			if DefaultJWT.payloadType == nil {
-				next.ServeHTTP(w, r)
-				return
-			}
-
-			// parse and set jwt payload in the context
-			claims, ok := token.Claims.(jwt.MapClaims)
-			if !ok {
-				slog.Error("Failed to parse JWT payload")
-				unauthorizedResponse(w)
-				return
-			}
-
-			p, err := helpers.NewValue(DefaultJWT.payloadType)
-			if err != nil {
-				slog.Error("Failed to initiate JWT payload type", golog.Extra(map[string]any{
-					"error": err.Error(),
-				}))
-				unauthorizedResponse(w)
-				return
-			}
-			pi := p.Interface()
-			if err := mapstructure.Decode(claims, pi); err != nil {
-				slog.Error("Failed to decode payload type", golog.Extra(map[string]any{
-					"error": err.Error(),
-				}))
-				unauthorizedResponse(w)
-				return
-			}
-			wrappedRequest := r.WithContext(context.WithValue(r.Context(), jwtPayloadKey, pi))
-			next.ServeHTTP(w, wrappedRequest)
+			DefaultJWT.authenticate(w, r, next, queryKeys)
 		}
 
 		return http.HandlerFunc(fn)
@@ -163,3 +290,10 @@ func Authenticate(queryKeys ...string) gohttputil.Middleware {
 func JWTPayload(r *http.Request) any {
 	return r.Context().Value(jwtPayloadKey)
 }
+
+// Claims returns the JWT payload found in authentication stage as *T,
+// where T is the payload type set via JWTWithPayloadType.
+// The second return value is false if there's no payload of type T.
+func Claims[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, jwtPayloadKey)
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	gohttputil "github.com/asif-mahmud/go-httputil"
 	"github.com/asif-mahmud/go-httputil/helpers"
 	"github.com/asif-mahmud/go-httputil/middlewares"
 	"github.com/golang-jwt/jwt/v5"
@@ -141,6 +142,10 @@ func TestWithPaylodType(t *testing.T) {
 
 	// response body will contain payload found from jwt
 	m(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, ok := middlewares.Claims[user](r)
+		assert.True(t, ok)
+		assert.Equal(t, &user{1, "Customer"}, u)
+
 		helpers.SendData(w, middlewares.JWTPayload(r))
 	})).ServeHTTP(w, r)
 
@@ -152,3 +157,62 @@ func TestWithPaylodType(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expected, string(actual))
 }
+
+func TestJWTInstances(t *testing.T) {
+	t.Parallel()
+
+	type service struct {
+		Name string
+	}
+
+	users := middlewares.NewJWT(
+		middlewares.JWTWithSecret("user-secret"),
+		middlewares.JWTWithPayloadType(user{}),
+	)
+	services := middlewares.NewJWT(
+		middlewares.JWTWithSecret("service-secret"),
+		middlewares.JWTWithPayloadType(service{}),
+	)
+
+	m := gohttputil.New()
+	m.Group("/api").Use(users.Middleware()).Route("/me", func(rh gohttputil.RouteHandler) {
+		rh.Get(func(w http.ResponseWriter, r *http.Request) {
+			u, _ := middlewares.Claims[user](r)
+			helpers.SendData(w, u.UserType)
+		})
+	})
+	m.Group("/internal").Use(services.Middleware()).Route("/sync", func(rh gohttputil.RouteHandler) {
+		rh.Get(func(w http.ResponseWriter, r *http.Request) {
+			s, _ := middlewares.Claims[service](r)
+			helpers.SendData(w, s.Name)
+		})
+	})
+
+	userToken, _ := users.Sign(jwt.SigningMethodHS256, dummyClaims(user{1, "Admin"}))
+	serviceToken, _ := services.Sign(jwt.SigningMethodHS256, jwt.MapClaims{"Name": "billing"})
+
+	type testCase struct {
+		path             string
+		token            string
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	testCases := []testCase{
+		{"/api/me", userToken, http.StatusOK, `{"data":"Admin","message":"Success","status":true}`},
+		{"/api/me", serviceToken, http.StatusUnauthorized, `{"data":null,"message":"Unauthorized","status":false}`},
+		{"/internal/sync", serviceToken, http.StatusOK, `{"data":"billing","message":"Success","status":true}`},
+		{"/internal/sync", userToken, http.StatusUnauthorized, `{"data":null,"message":"Unauthorized","status":false}`},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest(http.MethodGet, c.path, nil)
+		r.Header.Add("Authorization", "Bearer "+c.token)
+		w := httptest.NewRecorder()
+
+		m.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code, c.path)
+		assert.Equal(t, c.expectedResponse, w.Body.String(), c.path)
+	}
+}
@@ -0,0 +1,51 @@
+package middlewares
+
+import (
+	"net/http"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/asif-mahmud/go-httputil/helpers"
+)
+
+// entityTooLargeResponse sends request entity too large response
+func entityTooLargeResponse(w http.ResponseWriter, r *http.Request) {
+	helpers.RespondError(w, r, http.StatusRequestEntityTooLarge, "Request entity too large", nil)
+}
+
+// limitBody limits the request body to n bytes. If the request declares
+// a larger content length it responds request entity too large and returns
+// false. A non-positive n means no limit.
+func limitBody(w http.ResponseWriter, r *http.Request, n int64) bool {
+	if n <= 0 {
+		return true
+	}
+
+	if r.ContentLength > n {
+		entityTooLargeResponse(w, r)
+		return false
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, n)
+	return true
+}
+
+// MaxBodySize limits the request body to n bytes.
+// Requests declaring a larger content length are responded with
+// request entity too large status right away. Otherwise reading more
+// than n bytes from the body fails with *http.MaxBytesError, which the
+// validation middlewares respond with request entity too large status.
+func MaxBodySize(n int64) gohttputil.Middleware {
+	m := func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if !limitBody(w, r, n) {
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+
+	return m
+}
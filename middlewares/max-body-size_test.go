@@ -0,0 +1,129 @@
+package middlewares_test
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+const entityTooLarge = `{"data":null,"message":"Request entity too large","status":false}`
+
+type sizeDTO struct {
+	Name string `json:"name" form:"name" validate:"required"`
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestMaxBodySize(t *testing.T) {
+	h := middlewares.MaxBodySize(16)(middlewares.ValidateJSON(sizeDTO{})(http.HandlerFunc(okHandler)))
+
+	type testCase struct {
+		body             io.Reader
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	cases := []testCase{
+		// known content length
+		{strings.NewReader(`{"name":"Asif Mahmud"}`), http.StatusRequestEntityTooLarge, entityTooLarge},
+		// unknown content length
+		{io.MultiReader(strings.NewReader(`{"name":"Asif Mahmud"}`)), http.StatusRequestEntityTooLarge, entityTooLarge},
+		{strings.NewReader(`{"name":"A"}`), http.StatusOK, ""},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodPost, "/", c.body)
+		r.Header.Add("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestValidateJSONMaxBytes(t *testing.T) {
+	h := middlewares.ValidateJSON(
+		sizeDTO{},
+		middlewares.PayloadWithMaxBytes(10),
+	)(http.HandlerFunc(okHandler))
+
+	r := httptest.NewRequest(http.MethodPost, "/", io.MultiReader(strings.NewReader(`{"name":"Asif Mahmud"}`)))
+	r.Header.Add("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, entityTooLarge, w.Body.String())
+}
+
+func TestValidateFormMaxBytes(t *testing.T) {
+	h := middlewares.ValidateForm(
+		sizeDTO{},
+		middlewares.PayloadWithMaxBytes(1024),
+		middlewares.PayloadWithMaxMemory(16),
+	)(http.HandlerFunc(okHandler))
+
+	for _, size := range []int{100, 2048} {
+		var b bytes.Buffer
+		mw := multipart.NewWriter(&b)
+		_ = mw.WriteField("name", "Asif")
+		fw, _ := mw.CreateFormFile("file", "file.txt")
+		_, _ = fw.Write(bytes.Repeat([]byte("a"), size))
+		_ = mw.Close()
+
+		r := httptest.NewRequest(http.MethodPost, "/", io.MultiReader(&b))
+		r.Header.Add("Content-Type", mw.FormDataContentType())
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		if size < 1024 {
+			assert.Equal(t, http.StatusOK, w.Code)
+		} else {
+			assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+			assert.Equal(t, entityTooLarge, w.Body.String())
+		}
+	}
+}
+
+func TestBindMaxBytes(t *testing.T) {
+	h := middlewares.Bind(
+		sizeDTO{},
+		middlewares.PayloadWithMaxBytes(16),
+	)(http.HandlerFunc(okHandler))
+
+	type testCase struct {
+		body             io.Reader
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	cases := []testCase{
+		{strings.NewReader(`{"name":"Asif Mahmud"}`), http.StatusRequestEntityTooLarge, entityTooLarge},
+		{io.MultiReader(strings.NewReader(`{"name":"Asif Mahmud"}`)), http.StatusRequestEntityTooLarge, entityTooLarge},
+		{strings.NewReader(`{"name":"A"}`), http.StatusOK, ""},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodPost, "/", c.body)
+		r.Header.Add("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
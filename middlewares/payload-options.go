@@ -0,0 +1,75 @@
+package middlewares
+
+import "github.com/asif-mahmud/go-httputil/validator"
+
+// PayloadOptions configures how the validation middlewares read request body
+// and respond validation errors. Body options are ignored by the middlewares
+// not reading the body, i.e ValidateQuery, ValidatePathValue, ValidateHeader
+// and ValidateCookie.
+type PayloadOptions struct {
+	maxBytes    int64
+	maxMemory   int64
+	jsonOptions *validator.JSONOptions
+	detailed    bool
+}
+
+// PayloadSetupFunc is the signature for setting up PayloadOptions via builder function.
+type PayloadSetupFunc func(*PayloadOptions) *PayloadOptions
+
+// newPayloadOptions creates PayloadOptions with defaults and applies setupFuncs.
+func newPayloadOptions(setupFuncs []PayloadSetupFunc) *PayloadOptions {
+	o := &PayloadOptions{
+		maxMemory: validator.DefaultMaxMemory,
+	}
+
+	for _, f := range setupFuncs {
+		o = f(o)
+	}
+
+	return o
+}
+
+// PayloadWithMaxBytes limits the request body to n bytes.
+// Larger bodies are responded with request entity too large status.
+// For multipart forms this is the limit of all parts together, including
+// the ones stored on disk. By default there's no limit.
+func PayloadWithMaxBytes(n int64) PayloadSetupFunc {
+	return func(o *PayloadOptions) *PayloadOptions {
+		o.maxBytes = n
+		return o
+	}
+}
+
+// PayloadWithMaxMemory sets the maximum bytes of multipart form parts
+// kept in memory. Rest of the parts are stored in temporary files on disk.
+// Defaults to validator.DefaultMaxMemory.
+func PayloadWithMaxMemory(n int64) PayloadSetupFunc {
+	return func(o *PayloadOptions) *PayloadOptions {
+		o.maxMemory = n
+		return o
+	}
+}
+
+// PayloadWithJSONOptions sets the JSON decoding options, overriding the
+// global ones set via validator.SetJSONOptions.
+func PayloadWithJSONOptions(jo validator.JSONOptions) PayloadSetupFunc {
+	return func(o *PayloadOptions) *PayloadOptions {
+		o.jsonOptions = &jo
+		return o
+	}
+}
+
+// PayloadWithStrictJSON rejects JSON bodies having unknown fields
+// or trailing data after the JSON value. See validator.StrictJSON.
+func PayloadWithStrictJSON() PayloadSetupFunc {
+	return PayloadWithJSONOptions(validator.StrictJSON)
+}
+
+// PayloadWithDetailedErrors responds validation errors as validator.ErrorDetail
+// instead of plain messages. See validator.FormatErrorsDetailed.
+func PayloadWithDetailedErrors() PayloadSetupFunc {
+	return func(o *PayloadOptions) *PayloadOptions {
+		o.detailed = true
+		return o
+	}
+}
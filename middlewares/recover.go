@@ -20,7 +20,7 @@ func Recover(next http.Handler) http.Handler {
 						"stack": string(debug.Stack()),
 					}),
 				)
-				helpers.SendError(w, http.StatusBadRequest, helpers.ErrorMsg, nil)
+				helpers.RespondError(w, r, http.StatusBadRequest, helpers.ErrorMsg, nil)
 			}
 		}()
 
@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"net/http"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/asif-mahmud/go-httputil/validator"
+)
+
+// ValidateCookie validates request cookies and stores validated payload in
+// the request's context. Fields are bound via the cookie tag.
+//
+// Detailed validation errors can be enabled via PayloadWithDetailedErrors.
+// Other options are ignored as the request body is not read.
+func ValidateCookie(dto any, setupFuncs ...PayloadSetupFunc) gohttputil.Middleware {
+	opts := newPayloadOptions(setupFuncs)
+	m := func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			validatePayload(
+				next,
+				dto,
+				func(p any) error {
+					return validator.BindCookies(r.Context(), r.Cookies(), p)
+				},
+				cookieCtxKey,
+				opts,
+			).ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+
+	return m
+}
+
+// CookiePayload returns the validated cookies stored in
+// request's context.
+func CookiePayload(r *http.Request) any {
+	return r.Context().Value(cookieCtxKey)
+}
+
+// Cookie returns the validated cookies stored in request's context
+// as *T, where T is the type of the dto passed to ValidateCookie.
+// The second return value is false if there's no payload of type T.
+func Cookie[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, cookieCtxKey)
+}
@@ -0,0 +1,67 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCookie(t *testing.T) {
+	type dto struct {
+		Session string   `json:"session" cookie:"session" validate:"required,min=8"`
+		Flags   []string `json:"flags"   cookie:"flag"`
+	}
+
+	h := middlewares.ValidateCookie(dto{})(
+		http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
+			d, ok := middlewares.Cookie[dto](req)
+			assert.True(t, ok)
+			helpers.SendData(wr, d)
+		}),
+	)
+
+	type testCase struct {
+		cookies          []*http.Cookie
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	cases := []testCase{
+		{
+			[]*http.Cookie{
+				{Name: "session", Value: "abcdefgh"},
+				{Name: "flag", Value: "a"},
+				{Name: "flag", Value: "b"},
+			},
+			http.StatusOK,
+			`{"data":{"session":"abcdefgh","flags":["a","b"]},"message":"Success","status":true}`,
+		},
+		{
+			[]*http.Cookie{{Name: "session", Value: "abc"}},
+			http.StatusBadRequest,
+			`{"data":{"session":"session must be at least 8 characters in length"},"message":"Validation error","status":false}`,
+		},
+		{
+			nil,
+			http.StatusBadRequest,
+			`{"data":{"session":"session is a required field"},"message":"Validation error","status":false}`,
+		},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, cookie := range c.cookies {
+			r.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
@@ -9,16 +9,26 @@ import (
 	"github.com/asif-mahmud/go-httputil/validator"
 )
 
-const (
-	formCtxKey = "_formPayload"
-	maxBytes   = 100 * 1024 * 1024
-)
-
 // ValidateForm validates request body and stores validated payload in
 // the request's context.
-func ValidateForm(dto any) gohttputil.Middleware {
+//
+// For multipart/form-data, files are bound into fields of type
+// *multipart.FileHeader or []*multipart.FileHeader via form tags.
+// These fields can be validated with maxfilesize and mimetypes tags,
+// see validator.BindMultipartForm.
+//
+// Body size limits can be set via PayloadWithMaxBytes and PayloadWithMaxMemory.
+//
+// Detailed validation errors can be enabled via PayloadWithDetailedErrors.
+func ValidateForm(dto any, setupFuncs ...PayloadSetupFunc) gohttputil.Middleware {
+	opts := newPayloadOptions(setupFuncs)
+
 	m := func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
+			if !limitBody(w, r, opts.maxBytes) {
+				return
+			}
+
 			validatePayload(
 				next,
 				dto,
@@ -32,16 +42,17 @@ func ValidateForm(dto any) gohttputil.Middleware {
 
 						return validator.BindUrlValues(r.Context(), r.Form, p)
 					} else if strings.HasPrefix(header, "multipart/form-data") {
-						if err := r.ParseMultipartForm(maxBytes); err != nil {
+						if err := r.ParseMultipartForm(opts.maxMemory); err != nil {
 							return err
 						}
 
-						return validator.BindUrlValues(r.Context(), r.MultipartForm.Value, p)
+						return validator.BindMultipartForm(r.Context(), r.MultipartForm, p)
 					} else {
 						return errors.New("invalid request")
 					}
 				},
 				formCtxKey,
+				opts,
 			).ServeHTTP(w, r)
 		}
 
@@ -56,3 +67,10 @@ func ValidateForm(dto any) gohttputil.Middleware {
 func FormPayload(r *http.Request) any {
 	return r.Context().Value(formCtxKey)
 }
+
+// Form returns the validated form payload stored in request's context
+// as *T, where T is the type of the dto passed to ValidateForm.
+// The second return value is false if there's no payload of type T.
+func Form[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, formCtxKey)
+}
@@ -121,3 +121,91 @@ func TestValidateForm_MultipartSlice(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
 }
+
+func TestValidateForm_Files(t *testing.T) {
+	type DTO struct {
+		Title  string                  `form:"title"  validate:"required"`
+		Avatar *multipart.FileHeader   `form:"avatar" validate:"required,maxfilesize=1KB,mimetypes=image/png image/jpeg"`
+		Photos []*multipart.FileHeader `form:"photos" validate:"max=2,dive,mimetypes=image/*"`
+	}
+
+	h := middlewares.ValidateForm(DTO{})(
+		http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
+			d, ok := middlewares.Form[DTO](req)
+			assert.True(t, ok)
+			assert.Equal(t, "avatar.png", d.Avatar.Filename)
+			assert.Len(t, d.Photos, 2)
+
+			wr.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 100)...)
+	text := []byte("plain text pretending to be an image")
+
+	type file struct {
+		field, name string
+		content     []byte
+	}
+
+	type testCase struct {
+		files            []file
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	cases := []testCase{
+		{
+			[]file{
+				{"avatar", "avatar.png", png},
+				{"photos", "1.png", png},
+				{"photos", "2.png", png},
+			},
+			http.StatusOK,
+			"",
+		},
+		{
+			[]file{
+				{"avatar", "avatar.png", text},
+				{"photos", "1.png", png},
+				{"photos", "2.png", text},
+				{"photos", "3.png", png},
+			},
+			http.StatusBadRequest,
+			`{"data":{"avatar":"avatar must be one of the following file types [image/png image/jpeg]","photos":"photos must contain at maximum 2 items"},"message":"Validation error","status":false}`,
+		},
+		{
+			[]file{
+				{"avatar", "avatar.png", append(png, make([]byte, 1024)...)},
+				{"photos", "2.png", text},
+			},
+			http.StatusBadRequest,
+			`{"data":{"avatar":"avatar must not be larger than 1KB","photos":["photos[0] must be one of the following file types [image/*]"]},"message":"Validation error","status":false}`,
+		},
+		{
+			nil,
+			http.StatusBadRequest,
+			`{"data":{"avatar":"avatar is a required field"},"message":"Validation error","status":false}`,
+		},
+	}
+
+	for _, c := range cases {
+		var b bytes.Buffer
+		mw := multipart.NewWriter(&b)
+		_ = mw.WriteField("title", "My photos")
+		for _, f := range c.files {
+			fw, _ := mw.CreateFormFile(f.field, f.name)
+			_, _ = fw.Write(f.content)
+		}
+		_ = mw.Close()
+
+		r := httptest.NewRequest(http.MethodPost, "/", &b)
+		r.Header.Add("Content-Type", mw.FormDataContentType())
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
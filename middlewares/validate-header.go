@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"net/http"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/asif-mahmud/go-httputil/validator"
+)
+
+// ValidateHeader validates request headers and stores validated payload in
+// the request's context. Fields are bound via the header tag.
+//
+// Detailed validation errors can be enabled via PayloadWithDetailedErrors.
+// Other options are ignored as the request body is not read.
+func ValidateHeader(dto any, setupFuncs ...PayloadSetupFunc) gohttputil.Middleware {
+	opts := newPayloadOptions(setupFuncs)
+	m := func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			validatePayload(
+				next,
+				dto,
+				func(p any) error {
+					return validator.BindHeaders(r.Context(), r.Header, p)
+				},
+				headerCtxKey,
+				opts,
+			).ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+
+	return m
+}
+
+// HeaderPayload returns the validated headers stored in
+// request's context.
+func HeaderPayload(r *http.Request) any {
+	return r.Context().Value(headerCtxKey)
+}
+
+// Header returns the validated headers stored in request's context
+// as *T, where T is the type of the dto passed to ValidateHeader.
+// The second return value is false if there's no payload of type T.
+func Header[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, headerCtxKey)
+}
@@ -0,0 +1,80 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateHeader(t *testing.T) {
+	type dto struct {
+		RequestId string   `header:"X-Request-ID" validate:"required,uuid"`
+		IfMatch   []string `header:"If-Match"     validate:"omitempty,max=2"`
+		Retries   int      `header:"X-Retries"    validate:"gte=0"`
+	}
+
+	h := middlewares.ValidateHeader(dto{})(
+		http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
+			d, ok := middlewares.Header[dto](req)
+			assert.True(t, ok)
+			helpers.SendData(wr, d)
+		}),
+	)
+
+	type testCase struct {
+		headers          http.Header
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	cases := []testCase{
+		{
+			http.Header{
+				"X-Request-Id": {"9b2f1a0e-4a53-4cf4-9d5e-3f5e4b7e1c2a"},
+				"If-Match":     {`"a"`, `"b"`},
+				"X-Retries":    {"3"},
+			},
+			http.StatusOK,
+			`{"data":{"RequestId":"9b2f1a0e-4a53-4cf4-9d5e-3f5e4b7e1c2a","IfMatch":["\"a\"","\"b\""],"Retries":3},"message":"Success","status":true}`,
+		},
+		{
+			http.Header{
+				"X-Request-Id": {"invalid"},
+				"If-Match":     {`"a"`, `"b"`, `"c"`},
+			},
+			http.StatusBadRequest,
+			`{"data":{"If-Match":"If-Match must contain at maximum 2 items","X-Request-ID":"X-Request-ID must be a valid UUID"},"message":"Validation error","status":false}`,
+		},
+		{
+			http.Header{
+				"X-Request-Id": {"9b2f1a0e-4a53-4cf4-9d5e-3f5e4b7e1c2a"},
+				"If-Match":     {`"a", "b,c"`},
+			},
+			http.StatusOK,
+			`{"data":{"RequestId":"9b2f1a0e-4a53-4cf4-9d5e-3f5e4b7e1c2a","IfMatch":["\"a\"","\"b,c\""],"Retries":0},"message":"Success","status":true}`,
+		},
+		{
+			http.Header{
+				"X-Request-Id": {"9b2f1a0e-4a53-4cf4-9d5e-3f5e4b7e1c2a"},
+				"If-Match":     {`"a", "b", "c"`},
+			},
+			http.StatusBadRequest,
+			`{"data":{"If-Match":"If-Match must contain at maximum 2 items"},"message":"Validation error","status":false}`,
+		},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header = c.headers
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
@@ -9,11 +9,16 @@ import (
 	"github.com/asif-mahmud/go-httputil/validator"
 )
 
-const jsonCtxKey = "_jsonPaylod"
-
 // ValidateJSON validates JSON body and stores validated payload in
 // the request's context.
-func ValidateJSON(dto any) gohttputil.Middleware {
+//
+// Body size limit can be set via PayloadWithMaxBytes and JSON decoding
+// options via PayloadWithJSONOptions or PayloadWithStrictJSON.
+//
+// Detailed validation errors can be enabled via PayloadWithDetailedErrors.
+func ValidateJSON(dto any, setupFuncs ...PayloadSetupFunc) gohttputil.Middleware {
+	opts := newPayloadOptions(setupFuncs)
+
 	m := func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			// check header conformity
@@ -21,7 +26,11 @@ func ValidateJSON(dto any) gohttputil.Middleware {
 			contentType = strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
 			if contentType != "application/json" {
 				slog.Error("Looking for json body, but json header is not set")
-				badrequest(w, "Invalid request", nil)
+				badrequest(w, r, "Invalid request", nil)
+				return
+			}
+
+			if !limitBody(w, r, opts.maxBytes) {
 				return
 			}
 
@@ -30,9 +39,13 @@ func ValidateJSON(dto any) gohttputil.Middleware {
 				next,
 				dto,
 				func(p any) error {
+					if opts.jsonOptions != nil {
+						return validator.BindJSONWithOptions(r.Context(), r.Body, p, *opts.jsonOptions)
+					}
 					return validator.BindJSON(r.Context(), r.Body, p)
 				},
 				jsonCtxKey,
+				opts,
 			).ServeHTTP(w, r)
 		}
 
@@ -47,3 +60,10 @@ func ValidateJSON(dto any) gohttputil.Middleware {
 func JSONPayload(r *http.Request) any {
 	return r.Context().Value(jsonCtxKey)
 }
+
+// JSON returns the validated JSON payload stored in request's context
+// as *T, where T is the type of the dto passed to ValidateJSON.
+// The second return value is false if there's no payload of type T.
+func JSON[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, jsonCtxKey)
+}
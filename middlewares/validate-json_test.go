@@ -9,6 +9,9 @@ import (
 
 	"github.com/asif-mahmud/go-httputil/helpers"
 	"github.com/asif-mahmud/go-httputil/middlewares"
+	"github.com/asif-mahmud/go-httputil/validator"
+	"github.com/go-playground/locales/fr"
+	frtrans "github.com/go-playground/validator/v10/translations/fr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -112,3 +115,193 @@ func TestValidateJSON_Slice(t *testing.T) {
 	assert.Contains(t, string(actual), `"test1"`)
 	assert.Contains(t, string(actual), `"test2"`)
 }
+
+func TestJSONAccessor(t *testing.T) {
+	type dto struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	type other struct{}
+
+	h := middlewares.ValidateJSON(dto{})(
+		http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
+			d, ok := middlewares.JSON[dto](req)
+			assert.True(t, ok)
+			assert.Equal(t, "Asif", d.Name)
+
+			_, ok = middlewares.JSON[other](req)
+			assert.False(t, ok)
+
+			_, ok = middlewares.Query[dto](req)
+			assert.False(t, ok)
+
+			wr.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"name":"Asif"}`))
+	r.Header.Add("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// no payload in context at all
+	_, ok := middlewares.JSON[dto](httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.False(t, ok)
+}
+
+func TestValidateJSONStrict(t *testing.T) {
+	type dto struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	strict := middlewares.ValidateJSON(dto{}, middlewares.PayloadWithStrictJSON())(http.HandlerFunc(okHandler))
+	lenient := middlewares.ValidateJSON(dto{})(http.HandlerFunc(okHandler))
+
+	type testCase struct {
+		handler          http.Handler
+		payload          string
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	testCases := []testCase{
+		{strict, `{"name":"Asif"}`, http.StatusOK, ""},
+		{
+			strict,
+			`{"name":"Asif","nmae":"Asif"}`,
+			http.StatusBadRequest,
+			`{"data":{"nmae":"nmae is not a valid field"},"message":"Validation error","status":false}`,
+		},
+		{
+			strict,
+			`{"name":"Asif"} garbage`,
+			http.StatusBadRequest,
+			`{"data":null,"message":"Malformed JSON","status":false}`,
+		},
+		{
+			strict,
+			`{"name":"Asif"}{"name":"Asif"}`,
+			http.StatusBadRequest,
+			`{"data":null,"message":"Malformed JSON","status":false}`,
+		},
+		{
+			lenient,
+			`[]`,
+			http.StatusBadRequest,
+			`{"data":null,"message":"Malformed JSON","status":false}`,
+		},
+		{lenient, `{"name":"Asif","nmae":"Asif"} garbage`, http.StatusOK, ""},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(c.payload))
+		r.Header.Add("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		c.handler.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestValidateJSONLocale(t *testing.T) {
+	assert.Nil(t, validator.RegisterLocale(fr.New(), frtrans.RegisterDefaultTranslations))
+
+	type dto struct {
+		Name string `json:"name" validate:"required"`
+		Age  int    `json:"age"`
+	}
+
+	h := middlewares.ValidateJSON(dto{})(http.HandlerFunc(okHandler))
+
+	type testCase struct {
+		payload          string
+		acceptLanguage   string
+		expectedResponse string
+	}
+
+	testCases := []testCase{
+		{`{}`, "fr-FR", `{"data":{"name":"name est un champ obligatoire"},"message":"Validation error","status":false}`},
+		{`{}`, "es", `{"data":{"name":"name is a required field"},"message":"Validation error","status":false}`},
+		// binding errors without french translations fall back to english
+		{`{"name":"Asif","age":"12"}`, "fr", `{"data":{"age":"age must be an integer"},"message":"Validation error","status":false}`},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(c.payload))
+		r.Header.Add("Content-Type", "application/json")
+		r.Header.Add("Accept-Language", c.acceptLanguage)
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestValidateJSONDetailedErrors(t *testing.T) {
+	type dto struct {
+		Name string `json:"name" validate:"required,min=3"`
+		Age  int    `json:"age"`
+	}
+
+	h := middlewares.ValidateJSON(dto{}, middlewares.PayloadWithDetailedErrors())(http.HandlerFunc(okHandler))
+
+	type testCase struct {
+		payload          string
+		expectedResponse string
+	}
+
+	testCases := []testCase{
+		{
+			`{"name":"As"}`,
+			`{"data":{"name":{"code":"min","param":"3","message":"name must be at least 3 characters in length"}},"message":"Validation error","status":false}`,
+		},
+		{
+			`{"name":"Asif","age":"12"}`,
+			`{"data":{"age":{"code":"bind_integer","param":"","message":"age must be an integer"}},"message":"Validation error","status":false}`,
+		},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(c.payload))
+		r.Header.Add("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, c.expectedResponse, w.Body.String())
+	}
+}
+
+func TestValidateJSONProblemDetails(t *testing.T) {
+	helpers.SetErrorFormat(helpers.ErrorFormatNegotiate)
+	defer helpers.SetErrorFormat(helpers.ErrorFormatEnvelope)
+
+	type dto struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	h := middlewares.ValidateJSON(dto{})(http.HandlerFunc(okHandler))
+
+	r := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBufferString(`{}`))
+	r.Header.Add("Content-Type", "application/json")
+	r.Header.Add("Accept", helpers.ProblemContentType)
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, helpers.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Equal(
+		t,
+		`{"type":"about:blank","title":"Bad Request","status":400,"detail":"Validation error","instance":"/users","errors":{"name":"name is a required field"}}`,
+		w.Body.String(),
+	)
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/asif-mahmud/go-httputil/validator"
 )
 
-const pathValueCtxKey = "_pathValuePayload"
-
 // ValidatePathValue validates request path parameters and stores validated payload in
 // the request's context.
-func ValidatePathValue(dto any) gohttputil.Middleware {
+//
+// Detailed validation errors can be enabled via PayloadWithDetailedErrors.
+// Other options are ignored as the request body is not read.
+func ValidatePathValue(dto any, setupFuncs ...PayloadSetupFunc) gohttputil.Middleware {
+	opts := newPayloadOptions(setupFuncs)
 	m := func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			validatePayload(
@@ -21,6 +23,7 @@ func ValidatePathValue(dto any) gohttputil.Middleware {
 					return validator.BindPathValues(r.Context(), r, p)
 				},
 				pathValueCtxKey,
+				opts,
 			).ServeHTTP(w, r)
 		}
 		return http.HandlerFunc(fn)
@@ -33,3 +36,10 @@ func ValidatePathValue(dto any) gohttputil.Middleware {
 func PathValuePayload(r *http.Request) any {
 	return r.Context().Value(pathValueCtxKey)
 }
+
+// PathValue returns the validated path parameters stored in request's context
+// as *T, where T is the type of the dto passed to ValidatePathValue.
+// The second return value is false if there's no payload of type T.
+func PathValue[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, pathValueCtxKey)
+}
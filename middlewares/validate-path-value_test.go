@@ -80,3 +80,23 @@ func TestValidatePathMiddlewareInvalidName(t *testing.T) {
 		t.Errorf("Expected response body %v, got %v", expectedResponse, rr.Body.String())
 	}
 }
+
+// Test ValidatePath middleware with non numeric id
+func TestValidatePathMiddlewareInvalidId(t *testing.T) {
+	mux := http.NewServeMux()
+
+	mux.Handle("/users/{id}/{name}", middlewares.ValidatePathValue(
+		dto{},
+	)(http.HandlerFunc(getUserHandler)))
+
+	rr := performRequest(mux, "GET", "/users/abc/John")
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Errorf("Expected status code 400, got %v", status)
+	}
+
+	expectedResponse := `{"data":{"id":"id must be an integer"},"message":"Validation error","status":false}`
+	if rr.Body.String() != expectedResponse {
+		t.Errorf("Expected response body %v, got %v", expectedResponse, rr.Body.String())
+	}
+}
@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -12,15 +13,16 @@ import (
 	vd "github.com/go-playground/validator/v10"
 )
 
-func badrequest(w http.ResponseWriter, msg string, data any) {
-	helpers.SendError(w, http.StatusBadRequest, msg, data)
+func badrequest(w http.ResponseWriter, r *http.Request, msg string, data any) {
+	helpers.RespondError(w, r, http.StatusBadRequest, msg, data)
 }
 
 func validatePayload(
 	next http.Handler,
 	dto any,
 	bindFunc func(any) error,
-	ctxKey string,
+	key ctxKey,
+	opts *PayloadOptions,
 ) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
 		// initialize payload struct
@@ -29,17 +31,36 @@ func validatePayload(
 			slog.Error("Failed to initialize payload instance", golog.Extra(map[string]any{
 				"error": err.Error(),
 			}))
-			badrequest(w, helpers.ErrorMsg, nil)
+			badrequest(w, r, helpers.ErrorMsg, nil)
 			return
 		}
 		p := pv.Interface()
 
+		// messages are translated to the request's locale
+		ctx := validator.WithLocale(r.Context(), validator.RequestLocale(r))
+
 		// bind json body
 		if err := bindFunc(p); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				entityTooLargeResponse(w, r)
+				return
+			}
+
+			if errors.Is(err, validator.ErrMalformedJSON) {
+				badrequest(w, r, "Malformed JSON", nil)
+				return
+			}
+
+			if data, ok := formatBindError(ctx, err, opts); ok {
+				badrequest(w, r, "Validation error", data)
+				return
+			}
+
 			slog.Error("Failed to bind payload", golog.Extra(map[string]any{
 				"error": err.Error(),
 			}))
-			badrequest(w, helpers.ErrorMsg, nil)
+			badrequest(w, r, helpers.ErrorMsg, nil)
 			return
 		}
 
@@ -47,22 +68,40 @@ func validatePayload(
 		if err := validator.ValidateStruct(r.Context(), p); err != nil {
 			switch e := err.(type) {
 			case vd.ValidationErrors:
-				badrequest(w, "Validation error", validator.FormatErrors(e))
+				badrequest(w, r, "Validation error", formatErrors(ctx, e, opts))
 				return
 
 			default:
 				slog.Error("Failed to run validation", golog.Extra(map[string]any{
 					"error": err.Error(),
 				}))
-				badrequest(w, helpers.ErrorMsg, nil)
+				badrequest(w, r, helpers.ErrorMsg, nil)
 				return
 			}
 		}
 
 		// store in request context
-		wrappedRequest := r.WithContext(context.WithValue(r.Context(), ctxKey, p))
+		wrappedRequest := r.WithContext(context.WithValue(r.Context(), key, p))
 		next.ServeHTTP(w, wrappedRequest)
 	}
 
 	return http.HandlerFunc(fn)
 }
+
+// formatErrors formats validation errors as configured by opts.
+func formatErrors(ctx context.Context, err vd.ValidationErrors, opts *PayloadOptions) any {
+	if opts.detailed {
+		return validator.FormatErrorsDetailedContext(ctx, err)
+	}
+
+	return validator.FormatErrorsContext(ctx, err)
+}
+
+// formatBindError formats binding errors as configured by opts.
+func formatBindError(ctx context.Context, err error, opts *PayloadOptions) (any, bool) {
+	if opts.detailed {
+		return validator.FormatBindErrorDetailedContext(ctx, err)
+	}
+
+	return validator.FormatBindErrorContext(ctx, err)
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/asif-mahmud/go-httputil/validator"
 )
 
-const queryCtxKey = "_queryPayload"
-
 // ValidateQuery validates request search query and stores validated payload in
 // the request's context.
-func ValidateQuery(dto any) gohttputil.Middleware {
+//
+// Detailed validation errors can be enabled via PayloadWithDetailedErrors.
+// Other options are ignored as the request body is not read.
+func ValidateQuery(dto any, setupFuncs ...PayloadSetupFunc) gohttputil.Middleware {
+	opts := newPayloadOptions(setupFuncs)
 	m := func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			validatePayload(
@@ -21,6 +23,7 @@ func ValidateQuery(dto any) gohttputil.Middleware {
 					return validator.BindUrlValues(r.Context(), r.URL.Query(), p)
 				},
 				queryCtxKey,
+				opts,
 			).ServeHTTP(w, r)
 		}
 
@@ -35,3 +38,10 @@ func ValidateQuery(dto any) gohttputil.Middleware {
 func QueryPayload(r *http.Request) any {
 	return r.Context().Value(queryCtxKey)
 }
+
+// Query returns the validated query payload stored in request's context
+// as *T, where T is the type of the dto passed to ValidateQuery.
+// The second return value is false if there's no payload of type T.
+func Query[T any](r *http.Request) (*T, bool) {
+	return payload[T](r, queryCtxKey)
+}
@@ -0,0 +1,54 @@
+package gohttputil
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+)
+
+// Mount attaches h to handle every request under prefix with the
+// current global middlewares applied. The prefix is stripped from the
+// request path before calling h, so h sees paths relative to prefix.
+// This makes it possible to compose independently built Mux instances
+// or any other http.Handler into one server.
+//
+// The prefix must be a literal path without wildcards.
+func (m *Mux) Mount(prefix string, h http.Handler) *Mux {
+	m.mount("", prefix, h, m.middlewares, nil)
+	return m
+}
+
+// Mount implements Group.
+func (g *group) Mount(prefix string, h http.Handler) Group {
+	g.mux.mount(g.prefix, prefix, h, g.middlewares, g.responder)
+	return g
+}
+
+// mount registers h as a subtree handler for groupPrefix + prefix
+// wrapped with middlewares. rs is the Responder of the group, if any.
+func (m *Mux) mount(groupPrefix, prefix string, h http.Handler, middlewares []Middleware, rs helpers.Responder) {
+	strip := strings.TrimSuffix(groupPrefix+prefix, "/")
+	if strings.ContainsAny(strip, "{}") {
+		panic(fmt.Sprintf("gohttputil: mount prefix %q must not contain wildcards", strip))
+	}
+
+	h = http.StripPrefix(strip, h)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+
+	if rs != nil {
+		h = withResponder(rs, h)
+	}
+
+	pattern := strip + "/"
+	m.mux.Handle(pattern, h)
+	m.routes = append(m.routes, RouteInfo{
+		Pattern:     pattern,
+		Prefix:      groupPrefix,
+		Middlewares: middlewareNames(middlewares),
+		Mounted:     true,
+	})
+}
@@ -0,0 +1,83 @@
+package gohttputil_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountMux(t *testing.T) {
+	sub := gohttputil.New()
+	sub.Use(middleware1)
+	sub.Route("/users/{id}").Get(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path + " " + r.PathValue("id")))
+	})
+
+	m := gohttputil.New()
+	m.Use(globalMiddleware)
+	m.Mount("/accounts", sub)
+
+	checkResponse(t, m, http.MethodGet, "/accounts/users/1", "/users/1 1", http.Header{
+		"Content-Type":        {"text/plain; charset=utf-8"},
+		"X-Global-Middleware": {"1"},
+		"X-Middleware1":       {"1"},
+	})
+
+	// not found inside the mounted mux is handled by the mounted mux
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/accounts/orders", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, `{"data":null,"message":"Not found","status":false}`, w.Body.String())
+}
+
+func TestMountInGroup(t *testing.T) {
+	legacy := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	})
+
+	m := gohttputil.New()
+	m.Use(globalMiddleware)
+
+	m.Group("/api").
+		Use(groupMiddleware1).
+		Mount("/legacy/", legacy).
+		Route("/users", func(rh gohttputil.RouteHandler) {
+			rh.Get(handler1)
+		})
+
+	// middlewares added after mounting are not applied to the mounted handler
+	m.Use(globalMiddleware1)
+
+	checkResponse(t, m, http.MethodPost, "/api/legacy/a/b", "/a/b", http.Header{
+		"Content-Type":        {"text/plain; charset=utf-8"},
+		"X-Global-Middleware": {"1"},
+		"X-Group-Middleware1": {"1"},
+	})
+
+	checkResponse(t, m, http.MethodGet, "/api/legacy/", "/", http.Header{
+		"Content-Type":        {"text/plain; charset=utf-8"},
+		"X-Global-Middleware": {"1"},
+		"X-Group-Middleware1": {"1"},
+	})
+
+	routes := m.Routes()
+
+	assert.Len(t, routes, 2)
+	assert.Equal(t, "", routes[0].Method)
+	assert.Equal(t, "/api/legacy/", routes[0].Pattern)
+	assert.Equal(t, "/api", routes[0].Prefix)
+	assert.True(t, routes[0].Mounted)
+	assert.False(t, routes[1].Mounted)
+}
+
+func TestMountWithWildcard(t *testing.T) {
+	m := gohttputil.New()
+
+	assert.Panics(t, func() {
+		m.Mount("/tenants/{id}", http.NotFoundHandler())
+	})
+}
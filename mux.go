@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"slices"
 
+	"github.com/asif-mahmud/go-httputil/helpers"
 	"github.com/rs/cors"
 )
 
@@ -22,29 +23,83 @@ import (
 // Group level middlewares are only applied to the group routes.
 //
 // Route level middlewares are applied per route per method.
+//
+// Requests not matching any route are handled by the NotFound and
+// MethodNotAllowed handlers wrapped with global middlewares.
 type Mux struct {
-	mux         *http.ServeMux
-	middlewares []Middleware
-	corsHandler *cors.Cors
+	mux              *http.ServeMux
+	middlewares      []Middleware
+	corsHandler      *corsPolicy
+	routes           []RouteInfo
+	names            map[string]string
+	patternNames     map[string]string
+	preflights       map[string]*preflight
+	corsPatterns     map[string]struct{}
+	responder        helpers.Responder
+	notFound         http.Handler
+	methodNotAllowed http.Handler
 }
 
 // New creates a new instance of Mux.
 func New() *Mux {
 	return &Mux{
-		mux:         &http.ServeMux{},
-		middlewares: []Middleware{},
+		mux:              &http.ServeMux{},
+		middlewares:      []Middleware{},
+		names:            map[string]string{},
+		patternNames:     map[string]string{},
+		preflights:       map[string]*preflight{},
+		corsPatterns:     map[string]struct{}{},
+		notFound:         http.HandlerFunc(notFoundHandler),
+		methodNotAllowed: http.HandlerFunc(methodNotAllowedHandler),
 	}
 }
 
 // ServeHTTP implements http.Handler.
 // This calls the internal http.ServeMux.ServeHTTP with
-// or without CORS wrapper handler.
+// or without CORS wrapper handler. Routes having their own
+// CORS policy are not wrapped by the global CORS handler.
 func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if m.corsHandler != nil {
-		m.corsHandler.ServeHTTP(w, r, m.mux.ServeHTTP)
+	if m.responder != nil {
+		r = r.WithContext(helpers.WithResponder(r.Context(), m.responder))
+	}
+
+	h, pattern := m.mux.Handler(r)
+
+	if _, ok := m.corsPatterns[pattern]; m.corsHandler != nil && !ok {
+		m.corsHandler.ServeHTTP(w, r, func(w http.ResponseWriter, r *http.Request) {
+			m.serve(w, r, h, pattern)
+		})
+		return
+	}
+	m.serve(w, r, h, pattern)
+}
+
+// serve dispatches the request to the matching route or
+// to one of the fallback handlers if no route matches.
+// h and pattern are the values found by http.ServeMux.Handler.
+func (m *Mux) serve(w http.ResponseWriter, r *http.Request, h http.Handler, pattern string) {
+	if len(pattern) > 0 {
+		m.mux.ServeHTTP(w, r)
 		return
 	}
-	m.mux.ServeHTTP(w, r)
+
+	// http.ServeMux returns an empty pattern only when it is about to respond
+	// with 404 or 405. Run its handler against a probe to find out which one,
+	// along with the Allow header in case of 405.
+	probe := &probeWriter{header: http.Header{}}
+	h.ServeHTTP(probe, r)
+
+	fallback := m.notFound
+	if probe.status == http.StatusMethodNotAllowed {
+		w.Header().Set("Allow", probe.header.Get("Allow"))
+		fallback = m.methodNotAllowed
+	}
+
+	for i := len(m.middlewares) - 1; i >= 0; i-- {
+		fallback = m.middlewares[i](fallback)
+	}
+
+	fallback.ServeHTTP(w, r)
 }
 
 // Use appends middlewares to global middlewares.
@@ -56,7 +111,7 @@ func (r *Mux) Use(middlewares ...Middleware) *Mux {
 // Route implements Router.
 func (r *Mux) Route(route string) RouteHandler {
 	return &routeHandler{
-		mux:             r.mux,
+		mux:             r,
 		route:           route,
 		rootMiddlewares: slices.Clone(r.middlewares),
 		middlewares:     []Middleware{},
@@ -66,21 +121,69 @@ func (r *Mux) Route(route string) RouteHandler {
 // Group implements Grouper.
 func (m *Mux) Group(prefix string) Group {
 	return &group{
-		mux:         m.mux,
+		mux:         m,
 		prefix:      prefix,
 		middlewares: slices.Clone(m.middlewares),
 	}
 }
 
+// NotFound sets the handler used when no route matches the request path.
+// By default it responds with 404 status in the helpers response structure.
+// Global middlewares are applied to this handler.
+func (m *Mux) NotFound(h http.Handler) *Mux {
+	m.notFound = h
+	return m
+}
+
+// MethodNotAllowed sets the handler used when the request path matches
+// a route but not with the request method. The Allow header is set before
+// calling h. By default it responds with 405 status in the helpers
+// response structure. Global middlewares are applied to this handler.
+func (m *Mux) MethodNotAllowed(h http.Handler) *Mux {
+	m.methodNotAllowed = h
+	return m
+}
+
 // EnableCORS wraps the internal http.ServeMux with CORS handler.
 // Without any option in argument, it allows all methods, origins and
 // headers.
+//
+// This acts as the default CORS policy. Routes can have their own
+// policy via Group.CORS or RouteHandler.CORS.
 func (m *Mux) EnableCORS(opt ...cors.Options) {
-	c := cors.AllowAll()
-	if len(opt) > 0 {
-		c = cors.New(opt[0])
+	m.corsHandler = newCORS(opt)
+}
+
+func notFoundHandler(w http.ResponseWriter, r *http.Request) {
+	helpers.RespondError(w, r, http.StatusNotFound, "Not found", nil)
+}
+
+func methodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	helpers.RespondError(w, r, http.StatusMethodNotAllowed, "Method not allowed", nil)
+}
+
+// probeWriter is a http.ResponseWriter which only records
+// the status code and headers written to it.
+type probeWriter struct {
+	header http.Header
+	status int
+}
+
+func (p *probeWriter) Header() http.Header {
+	return p.header
+}
+
+func (p *probeWriter) Write(b []byte) (int, error) {
+	if p.status == 0 {
+		p.status = http.StatusOK
+	}
+	return len(b), nil
+}
+
+func (p *probeWriter) WriteHeader(status int) {
+	if p.status == 0 {
+		p.status = status
 	}
-	m.corsHandler = c
 }
 
 var (
@@ -77,3 +77,73 @@ func TestRestrictiveCORS(t *testing.T) {
 	assert.Equal(t, expectedStatusCode, http.StatusNoContent)
 	assert.Equal(t, expected, w.Header())
 }
+
+func TestNotFound(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Use(globalMiddleware)
+
+	m.Route("/users").Get(handler1)
+
+	checkResponse(
+		t,
+		m,
+		http.MethodGet,
+		"/orders",
+		`{"data":null,"message":"Not found","status":false}`,
+		http.Header{
+			"Content-Type":        {"application/json"},
+			"X-Global-Middleware": {"1"},
+		},
+	)
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Use(globalMiddleware)
+
+	m.Route("/users").Get(handler1).Post(handler1)
+
+	checkResponse(
+		t,
+		m,
+		http.MethodDelete,
+		"/users",
+		`{"data":null,"message":"Method not allowed","status":false}`,
+		http.Header{
+			"Allow":               {"GET, HEAD, POST"},
+			"Content-Type":        {"application/json"},
+			"X-Global-Middleware": {"1"},
+		},
+	)
+}
+
+func TestCustomFallbackHandlers(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users").Get(handler1)
+
+	m.
+		NotFound(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("custom not found"))
+		})).
+		MethodNotAllowed(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			w.Write([]byte("custom method not allowed"))
+		}))
+
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/orders", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "custom not found", w.Body.String())
+
+	w = httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/users", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "custom method not allowed", w.Body.String())
+	assert.Equal(t, "GET, HEAD", w.Header().Get("Allow"))
+}
@@ -0,0 +1,31 @@
+package gohttputil
+
+import (
+	"net/http"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+)
+
+// withResponder stores rs in the request context before calling next.
+func withResponder(rs helpers.Responder, next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(helpers.WithResponder(r.Context(), rs)))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// Responder sets the helpers.Responder used to write responses of all
+// requests served by this Mux, including the NotFound and MethodNotAllowed
+// ones. It overrides the global one set via helpers.SetResponder.
+// Handlers and middlewares use it through helpers.Respond and helpers.RespondError.
+func (m *Mux) Responder(rs helpers.Responder) *Mux {
+	m.responder = rs
+	return m
+}
+
+// Responder implements Group.
+func (g *group) Responder(rs helpers.Responder) Group {
+	g.responder = rs
+	return g
+}
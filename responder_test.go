@@ -0,0 +1,75 @@
+package gohttputil_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+type bareResponder struct{}
+
+func (bareResponder) Data(w http.ResponseWriter, r *http.Request, status int, data any) {
+	helpers.SendJSON(w, status, data)
+}
+
+func (bareResponder) Error(w http.ResponseWriter, r *http.Request, status int, message string, data any) {
+	helpers.SendJSON(w, status, message)
+}
+
+type resultResponder struct{}
+
+func (resultResponder) Data(w http.ResponseWriter, r *http.Request, status int, data any) {
+	helpers.SendJSON(w, status, map[string]any{"ok": true, "result": data})
+}
+
+func (resultResponder) Error(w http.ResponseWriter, r *http.Request, status int, message string, data any) {
+	helpers.SendJSON(w, status, map[string]any{"ok": false, "error": message})
+}
+
+func TestResponder(t *testing.T) {
+	respond := func(w http.ResponseWriter, r *http.Request) {
+		helpers.Respond(w, r, http.StatusOK, "ok")
+	}
+
+	m := gohttputil.New().Responder(bareResponder{})
+	m.Route("/{$}").Get(respond)
+
+	g := m.Group("/v2").Responder(resultResponder{})
+	g.Route("/items", func(rh gohttputil.RouteHandler) {
+		rh.Get(respond)
+	})
+	g.Group("/nested").Route("/items", func(rh gohttputil.RouteHandler) {
+		rh.Get(respond)
+	})
+	g.Mount("/static", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		helpers.RespondError(w, r, http.StatusNotFound, "File not found", nil)
+	}))
+
+	type testCase struct {
+		path             string
+		expectedStatus   int
+		expectedResponse string
+	}
+
+	testCases := []testCase{
+		{"/", http.StatusOK, `"ok"`},
+		{"/missing", http.StatusNotFound, `"Not found"`},
+		{"/v2/items", http.StatusOK, `{"ok":true,"result":"ok"}`},
+		{"/v2/nested/items", http.StatusOK, `{"ok":true,"result":"ok"}`},
+		{"/v2/static/app.js", http.StatusNotFound, `{"error":"File not found","ok":false}`},
+	}
+
+	for _, c := range testCases {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, c.path, nil)
+
+		m.ServeHTTP(w, r)
+
+		assert.Equal(t, c.expectedStatus, w.Code, c.path)
+		assert.Equal(t, c.expectedResponse, w.Body.String(), c.path)
+	}
+}
@@ -1,9 +1,11 @@
 package gohttputil
 
 import (
-	"fmt"
 	"net/http"
 	"slices"
+
+	"github.com/asif-mahmud/go-httputil/helpers"
+	"github.com/rs/cors"
 )
 
 // Router interface to create a RouteHandler
@@ -15,12 +17,33 @@ type Router interface {
 type RouteHandler interface {
 	// Use adds middlewares to be used for the current route and current method.
 	// This should be called before calling any of the http method handlers (Get,
-	// Post, Put, Patch or Delete method). After calling an http method handler
-	// this list of middlewares are cleared so that user can define different set
-	// of middlewares for next http method handler. Soel middlewares
-	// are defined per route per http method.
+	// Post, Put, Patch, Delete, Head, Options, Connect, Trace, Method or Any).
+	// After calling an http method handler this list of middlewares are cleared
+	// so that user can define different set of middlewares for next http method
+	// handler. Soel middlewares are defined per route per http method.
 	Use(...Middleware) RouteHandler
 
+	// Meta attaches metadata to the current route and current method.
+	// Like Use, this should be called before calling an http method handler
+	// and the metadata is cleared afterwards. Middlewares and handlers can
+	// read the metadata via RouteMeta or MetaValue.
+	Meta(key string, value any) RouteHandler
+
+	// CORS sets a CORS policy for the current route which overrides the
+	// global one set by Mux.EnableCORS and the group level one set by Group.CORS.
+	// Unlike Use, the policy applies to all http method handlers attached
+	// afterwards to this route. Without any option in argument, it allows
+	// all methods, origins and headers.
+	//
+	// An OPTIONS handler is registered automatically for the route to answer
+	// CORS preflight requests, unless one is attached explicitly. Attaching
+	// handlers with a different policy for the same pattern panics.
+	CORS(...cors.Options) RouteHandler
+
+	// Name names the route so that its URL can be built later via Mux.URL.
+	// The name applies to the route pattern regardless of http methods.
+	Name(string) RouteHandler
+
 	// Get attaches handler to http GET method
 	Get(http.HandlerFunc) RouteHandler
 
@@ -35,13 +58,39 @@ type RouteHandler interface {
 
 	// Delete attaches handler to http DELETE method
 	Delete(http.HandlerFunc) RouteHandler
+
+	// Head attaches handler to http HEAD method.
+	// Note that Get handlers already respond to HEAD requests unless
+	// a Head handler is attached explicitly.
+	Head(http.HandlerFunc) RouteHandler
+
+	// Options attaches handler to http OPTIONS method
+	Options(http.HandlerFunc) RouteHandler
+
+	// Connect attaches handler to http CONNECT method
+	Connect(http.HandlerFunc) RouteHandler
+
+	// Trace attaches handler to http TRACE method
+	Trace(http.HandlerFunc) RouteHandler
+
+	// Method attaches handler to an arbitrary http method, i.e
+	// WebDAV's PROPFIND or QUERY.
+	Method(string, http.HandlerFunc) RouteHandler
+
+	// Any attaches handler to the route without any method, so
+	// it handles every method not handled by a more specific handler.
+	Any(http.HandlerFunc) RouteHandler
 }
 
 type routeHandler struct {
-	mux             *http.ServeMux
+	mux             *Mux
+	prefix          string
 	route           string
 	rootMiddlewares []Middleware
 	middlewares     []Middleware
+	meta            Meta
+	cors            *corsPolicy
+	responder       helpers.Responder
 }
 
 // Use implements RouteHandler.
@@ -50,6 +99,27 @@ func (r *routeHandler) Use(middlewares ...Middleware) RouteHandler {
 	return r
 }
 
+// Meta implements RouteHandler.
+func (r *routeHandler) Meta(key string, value any) RouteHandler {
+	if r.meta == nil {
+		r.meta = Meta{}
+	}
+	r.meta[key] = value
+	return r
+}
+
+// CORS implements RouteHandler.
+func (r *routeHandler) CORS(opt ...cors.Options) RouteHandler {
+	r.cors = newCORS(opt)
+	return r
+}
+
+// Name implements RouteHandler.
+func (r *routeHandler) Name(name string) RouteHandler {
+	r.mux.name(name, r.prefix+r.route)
+	return r
+}
+
 func (r *routeHandler) createHandler(method string, handler http.HandlerFunc) {
 	var h http.Handler
 	h = http.HandlerFunc(handler)
@@ -62,12 +132,45 @@ func (r *routeHandler) createHandler(method string, handler http.HandlerFunc) {
 		h = r.rootMiddlewares[i](h)
 	}
 
-	r.mux.Handle(fmt.Sprintf("%s %s", method, r.route), h)
+	if r.responder != nil {
+		h = withResponder(r.responder, h)
+	}
+
+	meta := r.meta.clone()
+	if meta != nil {
+		h = withMeta(meta, h)
+	}
+
+	pattern := r.prefix + r.route
+	preflight := false
+	if r.cors != nil {
+		preflight = r.mux.handleWithCORS(method, pattern, h, r.cors, r.rootMiddlewares)
+	} else {
+		r.mux.handle(method, pattern, h)
+	}
+	r.mux.addRoute(RouteInfo{
+		Method:      method,
+		Pattern:     pattern,
+		Prefix:      r.prefix,
+		Middlewares: middlewareNames(r.rootMiddlewares, r.middlewares),
+		Meta:        meta,
+	})
+
+	if preflight {
+		r.mux.addRoute(RouteInfo{
+			Method:      http.MethodOptions,
+			Pattern:     pattern,
+			Prefix:      r.prefix,
+			Middlewares: middlewareNames(r.rootMiddlewares),
+			Automatic:   true,
+		})
+	}
 }
 
 func (r *routeHandler) reset() RouteHandler {
 	r.middlewares = []Middleware{}
 	r.rootMiddlewares = slices.Clone(r.rootMiddlewares)
+	r.meta = nil
 	return r
 }
 
@@ -101,4 +204,40 @@ func (r *routeHandler) Delete(handler http.HandlerFunc) RouteHandler {
 	return r.reset()
 }
 
+// Head implements RouteHandler.
+func (r *routeHandler) Head(handler http.HandlerFunc) RouteHandler {
+	r.createHandler(http.MethodHead, handler)
+	return r.reset()
+}
+
+// Options implements RouteHandler.
+func (r *routeHandler) Options(handler http.HandlerFunc) RouteHandler {
+	r.createHandler(http.MethodOptions, handler)
+	return r.reset()
+}
+
+// Connect implements RouteHandler.
+func (r *routeHandler) Connect(handler http.HandlerFunc) RouteHandler {
+	r.createHandler(http.MethodConnect, handler)
+	return r.reset()
+}
+
+// Trace implements RouteHandler.
+func (r *routeHandler) Trace(handler http.HandlerFunc) RouteHandler {
+	r.createHandler(http.MethodTrace, handler)
+	return r.reset()
+}
+
+// Method implements RouteHandler.
+func (r *routeHandler) Method(method string, handler http.HandlerFunc) RouteHandler {
+	r.createHandler(method, handler)
+	return r.reset()
+}
+
+// Any implements RouteHandler.
+func (r *routeHandler) Any(handler http.HandlerFunc) RouteHandler {
+	r.createHandler("", handler)
+	return r.reset()
+}
+
 var _ = (RouteHandler)(&routeHandler{})
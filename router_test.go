@@ -83,6 +83,18 @@ func methodHandler(t *testing.T, method, path string) {
 	case http.MethodDelete:
 		rh.Delete(handler1)
 
+	case http.MethodHead:
+		rh.Head(handler1)
+
+	case http.MethodOptions:
+		rh.Options(handler1)
+
+	case http.MethodConnect:
+		rh.Connect(handler1)
+
+	case http.MethodTrace:
+		rh.Trace(handler1)
+
 	default:
 		assert.Fail(t, "unsupported method")
 	}
@@ -134,6 +146,73 @@ func TestDelete(t *testing.T) {
 	methodHandler(t, http.MethodDelete, "/")
 }
 
+func TestHead(t *testing.T) {
+	methodHandler(t, http.MethodHead, "/")
+}
+
+func TestOptions(t *testing.T) {
+	methodHandler(t, http.MethodOptions, "/")
+}
+
+func TestConnect(t *testing.T) {
+	methodHandler(t, http.MethodConnect, "/")
+}
+
+func TestTrace(t *testing.T) {
+	methodHandler(t, http.MethodTrace, "/")
+}
+
+func TestCustomMethod(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Use(globalMiddleware)
+
+	m.Route("/files").
+		Use(middleware1).
+		Method("PROPFIND", handler2).
+		Method("QUERY", handler2)
+
+	checkResponse(t, m, "PROPFIND", "/files", `{"success":true,"method":"PROPFIND"}`, http.Header{
+		"Content-Type":        {"application/json"},
+		"X-Global-Middleware": {"1"},
+		"X-Middleware1":       {"1"},
+	})
+
+	checkResponse(t, m, "QUERY", "/files", `{"success":true,"method":"QUERY"}`, http.Header{
+		"Content-Type":        {"application/json"},
+		"X-Global-Middleware": {"1"},
+	})
+}
+
+func TestAny(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/any").
+		Use(middleware1).
+		Get(handler2).
+		Use(middleware2).
+		Any(handler2)
+
+	checkResponse(t, m, http.MethodGet, "/any", `{"success":true,"method":"GET"}`, http.Header{
+		"Content-Type":  {"application/json"},
+		"X-Middleware1": {"1"},
+	})
+
+	for _, method := range []string{http.MethodPost, http.MethodDelete, "PROPFIND"} {
+		checkResponse(
+			t,
+			m,
+			method,
+			"/any",
+			fmt.Sprintf(`{"success":true,"method":"%s"}`, method),
+			http.Header{
+				"Content-Type":  {"application/json"},
+				"X-Middleware2": {"2"},
+			},
+		)
+	}
+}
+
 func TestMultiple(t *testing.T) {
 	m := gohttputil.New()
 
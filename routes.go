@@ -0,0 +1,102 @@
+package gohttputil
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+)
+
+// RouteInfo describes a route registered through a Mux.
+type RouteInfo struct {
+	// Method is the http method of the route.
+	// It is empty for routes attached via RouteHandler.Any.
+	Method string
+
+	// Pattern is the full path pattern of the route including
+	// group prefixes, i.e "/api/v1/users/{id}".
+	Pattern string
+
+	// Name is the name of the route set via RouteHandler.Name.
+	// If the pattern has several names, it is the first one.
+	Name string
+
+	// Prefix is the group prefix the route was defined under.
+	// It is empty for routes defined directly on the Mux.
+	Prefix string
+
+	// Middlewares are the names of the middlewares applied to the route
+	// in the order they are applied (global, group and route level).
+	Middlewares []string
+
+	// Meta is the metadata attached to the route via RouteHandler.Meta.
+	Meta Meta
+
+	// Mounted reports whether the route is a subtree handler
+	// attached via Mount.
+	Mounted bool
+
+	// Automatic reports whether the route is an OPTIONS handler registered
+	// automatically to answer CORS preflight requests of a route having
+	// its own CORS policy. It is replaced by an OPTIONS route defined later
+	// for the same pattern.
+	Automatic bool
+}
+
+// addRoute records r in the route table. An automatically registered
+// route for the same method and pattern is replaced by r.
+func (m *Mux) addRoute(r RouteInfo) {
+	for i, route := range m.routes {
+		if route.Automatic && route.Method == r.Method && route.Pattern == r.Pattern {
+			m.routes[i] = r
+			return
+		}
+	}
+
+	m.routes = append(m.routes, r)
+}
+
+// Routes returns all routes registered so far in the order of registration.
+func (m *Mux) Routes() []RouteInfo {
+	routes := make([]RouteInfo, 0, len(m.routes))
+	for _, r := range m.routes {
+		r.Name = m.patternNames[r.Pattern]
+		r.Middlewares = slices.Clone(r.Middlewares)
+		r.Meta = r.Meta.clone()
+		routes = append(routes, r)
+	}
+
+	return routes
+}
+
+// Walk calls fn for every registered route in the order of registration.
+// Walking stops at the first error returned by fn and that error is returned.
+func (m *Mux) Walk(fn func(RouteInfo) error) error {
+	for _, r := range m.Routes() {
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// middlewareName returns the fully qualified function name of mw.
+func middlewareName(mw Middleware) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(mw).Pointer()); f != nil {
+		return f.Name()
+	}
+
+	return "unknown"
+}
+
+// middlewareNames returns the names of all middlewares in the lists.
+func middlewareNames(lists ...[]Middleware) []string {
+	names := []string{}
+	for _, l := range lists {
+		for _, mw := range l {
+			names = append(names, middlewareName(mw))
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,84 @@
+package gohttputil_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPkg = "github.com/asif-mahmud/go-httputil_test."
+
+func TestRoutes(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Use(globalMiddleware)
+
+	m.Route("/health").Get(handler1)
+
+	m.Group("/api").
+		Use(groupMiddleware1).
+		Group("/v1").
+		Route("/users/{id}", func(rh gohttputil.RouteHandler) {
+			rh.Get(handler1).Use(middleware1, middleware2).Delete(handler1)
+		})
+
+	expected := []gohttputil.RouteInfo{
+		{
+			Method:      http.MethodGet,
+			Pattern:     "/health",
+			Prefix:      "",
+			Middlewares: []string{testPkg + "globalMiddleware"},
+		},
+		{
+			Method:  http.MethodGet,
+			Pattern: "/api/v1/users/{id}",
+			Prefix:  "/api/v1",
+			Middlewares: []string{
+				testPkg + "globalMiddleware",
+				testPkg + "groupMiddleware1",
+			},
+		},
+		{
+			Method:  http.MethodDelete,
+			Pattern: "/api/v1/users/{id}",
+			Prefix:  "/api/v1",
+			Middlewares: []string{
+				testPkg + "globalMiddleware",
+				testPkg + "groupMiddleware1",
+				testPkg + "middleware1",
+				testPkg + "middleware2",
+			},
+		},
+	}
+
+	assert.Equal(t, expected, m.Routes())
+}
+
+func TestWalk(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/1").Get(handler1).Post(handler1)
+	m.Route("/2").Get(handler1)
+
+	visited := []string{}
+	err := m.Walk(func(ri gohttputil.RouteInfo) error {
+		visited = append(visited, ri.Method+" "+ri.Pattern)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /1", "POST /1", "GET /2"}, visited)
+
+	stop := errors.New("stop")
+	visited = []string{}
+	err = m.Walk(func(ri gohttputil.RouteInfo) error {
+		visited = append(visited, ri.Method+" "+ri.Pattern)
+		return stop
+	})
+
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []string{"GET /1"}, visited)
+}
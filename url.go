@@ -0,0 +1,98 @@
+package gohttputil
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrUnknownRoute is returned by Mux.URL when no route is named as requested.
+	ErrUnknownRoute = errors.New("unknown route")
+
+	// ErrMissingParam is returned by Mux.URL when a wildcard of the route
+	// pattern has no value in the provided parameters.
+	ErrMissingParam = errors.New("missing route parameter")
+)
+
+// name registers name for pattern. The first name registered for a pattern
+// is the one reported by Routes.
+// It panics if name is already registered for a different pattern.
+func (m *Mux) name(name, pattern string) {
+	if p, ok := m.names[name]; ok && p != pattern {
+		panic(fmt.Sprintf("gohttputil: route name %q already registered for %q", name, p))
+	}
+
+	m.names[name] = pattern
+	if _, ok := m.patternNames[pattern]; !ok {
+		m.patternNames[pattern] = name
+	}
+}
+
+// URL builds the URL path for the route registered with name. Wildcards in
+// the route pattern are replaced by values from params and query is
+// encoded as the search query.
+//
+// Values of single segment wildcards (i.e {id}) are path escaped as a
+// whole, while values of remaining wildcards (i.e {path...}) are
+// escaped per segment to preserve the slashes. Host part of the pattern,
+// if any, is not included in the URL.
+//
+// Example -
+//
+//	mux.Route("/users/{id}").Name("user").Get(getUser)
+//
+//	// "/users/1?tab=posts"
+//	u, err := mux.URL("user", map[string]string{"id": "1"}, url.Values{"tab": {"posts"}})
+func (m *Mux) URL(name string, params map[string]string, query url.Values) (string, error) {
+	pattern, ok := m.names[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownRoute, name)
+	}
+
+	// strip host part
+	if i := strings.Index(pattern, "/"); i > 0 {
+		pattern = pattern[i:]
+	}
+
+	segments := strings.Split(pattern, "/")
+	for i, s := range segments {
+		if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+			continue
+		}
+
+		key := s[1 : len(s)-1]
+		if key == "$" {
+			segments[i] = ""
+			continue
+		}
+
+		if key, ok := strings.CutSuffix(key, "..."); ok {
+			value, found := params[key]
+			if !found {
+				return "", fmt.Errorf("%w: %q for route %q", ErrMissingParam, key, name)
+			}
+
+			parts := strings.Split(value, "/")
+			for j, p := range parts {
+				parts[j] = url.PathEscape(p)
+			}
+			segments[i] = strings.Join(parts, "/")
+			continue
+		}
+
+		value := params[key]
+		if len(value) == 0 {
+			return "", fmt.Errorf("%w: %q for route %q", ErrMissingParam, key, name)
+		}
+		segments[i] = url.PathEscape(value)
+	}
+
+	u := strings.Join(segments, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	return u, nil
+}
@@ -0,0 +1,83 @@
+package gohttputil_test
+
+import (
+	"net/url"
+	"testing"
+
+	gohttputil "github.com/asif-mahmud/go-httputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURL(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users/{id}").Name("user").Get(handler1)
+	m.Group("/api").Group("/v1").Route("/files/{owner}/{path...}", func(rh gohttputil.RouteHandler) {
+		rh.Name("file").Get(handler1)
+	})
+	m.Route("/posts/{$}").Name("posts").Get(handler1)
+
+	type testCase struct {
+		name     string
+		params   map[string]string
+		query    url.Values
+		expected string
+	}
+
+	cases := []testCase{
+		{"user", map[string]string{"id": "1"}, nil, "/users/1"},
+		{"user", map[string]string{"id": "a b/c"}, nil, "/users/a%20b%2Fc"},
+		{"user", map[string]string{"id": "1"}, url.Values{"tab": {"posts"}}, "/users/1?tab=posts"},
+		{
+			"file",
+			map[string]string{"owner": "me", "path": "docs/a b.txt"},
+			nil,
+			"/api/v1/files/me/docs/a%20b.txt",
+		},
+		{"file", map[string]string{"owner": "me", "path": ""}, nil, "/api/v1/files/me/"},
+		{"posts", nil, nil, "/posts/"},
+	}
+
+	for _, c := range cases {
+		u, err := m.URL(c.name, c.params, c.query)
+
+		assert.Nil(t, err)
+		assert.Equal(t, c.expected, u)
+	}
+
+	assert.Equal(t, "user", m.Routes()[0].Name)
+}
+
+func TestRouteNames(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users/{id}").Name("user").Name("profile").Get(handler1)
+
+	for range 10 {
+		assert.Equal(t, "user", m.Routes()[0].Name)
+	}
+
+	u, err := m.URL("profile", map[string]string{"id": "1"}, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "/users/1", u)
+}
+
+func TestURLErrors(t *testing.T) {
+	m := gohttputil.New()
+
+	m.Route("/users/{id}/{path...}").Name("user").Get(handler1)
+
+	_, err := m.URL("unknown", nil, nil)
+	assert.ErrorIs(t, err, gohttputil.ErrUnknownRoute)
+
+	_, err = m.URL("user", map[string]string{"path": "a"}, nil)
+	assert.ErrorIs(t, err, gohttputil.ErrMissingParam)
+
+	_, err = m.URL("user", map[string]string{"id": "1"}, nil)
+	assert.ErrorIs(t, err, gohttputil.ErrMissingParam)
+
+	assert.Panics(t, func() {
+		m.Route("/other").Name("user")
+	})
+}
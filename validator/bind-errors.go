@@ -0,0 +1,251 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/form/v4"
+	ut "github.com/go-playground/universal-translator"
+)
+
+// ErrMalformedJSON is returned by the JSON binders when the body is not
+// a valid JSON document or its type doesn't match the destination at all.
+var ErrMalformedJSON = errors.New("malformed json")
+
+// BindFieldError is returned when a value bound via path, query, header or
+// cookie tags can not be converted to the field's type.
+type BindFieldError struct {
+	// Field is the tag value of the field, i.e the path parameter name.
+	Field string
+
+	// Type is the type the value was being converted to.
+	Type reflect.Type
+
+	// Err is the conversion error.
+	Err error
+}
+
+// Error implements error.
+func (e *BindFieldError) Error() string {
+	return fmt.Sprintf("failed to set field %s: %s", e.Field, e.Err.Error())
+}
+
+// Unwrap returns the conversion error.
+func (e *BindFieldError) Unwrap() error {
+	return e.Err
+}
+
+// translation keys of binding errors
+const (
+	unknownFieldTag = "unknown_field"
+	bindIntegerTag  = "bind_integer"
+	bindNumberTag   = "bind_number"
+	bindBooleanTag  = "bind_boolean"
+	bindStringTag   = "bind_string"
+	bindArrayTag    = "bind_array"
+	bindObjectTag   = "bind_object"
+	bindInvalidTag  = "bind_invalid"
+)
+
+// registerBindTranslations adds english translations of binding errors.
+func registerBindTranslations(trans ut.Translator) {
+	for tag, translation := range map[string]string{
+		unknownFieldTag: "{0} is not a valid field",
+		bindIntegerTag:  "{0} must be an integer",
+		bindNumberTag:   "{0} must be a number",
+		bindBooleanTag:  "{0} must be a boolean",
+		bindStringTag:   "{0} must be a string",
+		bindArrayTag:    "{0} must be an array",
+		bindObjectTag:   "{0} must be an object",
+		bindInvalidTag:  "{0} has an invalid value",
+	} {
+		trans.Add(tag, translation, false)
+	}
+}
+
+// typeTag returns the translation key for values failing to bind into t.
+func typeTag(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return bindIntegerTag
+	case reflect.Float32, reflect.Float64:
+		return bindNumberTag
+	case reflect.Bool:
+		return bindBooleanTag
+	case reflect.String:
+		return bindStringTag
+	case reflect.Slice, reflect.Array:
+		return bindArrayTag
+	case reflect.Struct, reflect.Map:
+		return bindObjectTag
+	default:
+		return bindInvalidTag
+	}
+}
+
+// formErrorTags maps form decoder error messages to translation keys.
+var formErrorTags = []struct {
+	prefix string
+	tag    string
+}{
+	{"Invalid Integer Value", bindIntegerTag},
+	{"Invalid Unsigned Integer Value", bindIntegerTag},
+	{"Invalid Float Value", bindNumberTag},
+	{"Invalid Boolean Value", bindBooleanTag},
+}
+
+// formErrorTag returns the translation key for a form decoder error.
+func formErrorTag(err error) string {
+	for _, t := range formErrorTags {
+		if strings.HasPrefix(err.Error(), t.prefix) {
+			return t.tag
+		}
+	}
+
+	return bindInvalidTag
+}
+
+// jsonIndex matches array indexes in encoding/json field paths.
+var jsonIndex = regexp.MustCompile(`\.(\d+)(\.|$)`)
+
+// jsonNamespace converts encoding/json field paths like "addresses.1.street"
+// into namespaces like "addresses[1].street".
+func jsonNamespace(field string) string {
+	for jsonIndex.MatchString(field) {
+		field = jsonIndex.ReplaceAllString(field, "[$1]$2")
+	}
+
+	return field
+}
+
+// bindErrorEntry is a translated binding error for a namespace.
+type bindErrorEntry struct {
+	namespace string
+	tag       string
+}
+
+// bindErrorEntries returns the entries of err, if err is a known binding error.
+func bindErrorEntries(err error) ([]bindErrorEntry, bool) {
+	var unknownFieldErr *UnknownFieldError
+	if errors.As(err, &unknownFieldErr) {
+		return []bindErrorEntry{{unknownFieldErr.Field, unknownFieldTag}}, true
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && len(typeErr.Field) > 0 {
+		return []bindErrorEntry{{jsonNamespace(typeErr.Field), typeTag(typeErr.Type)}}, true
+	}
+
+	var fieldErr *BindFieldError
+	if errors.As(err, &fieldErr) {
+		return []bindErrorEntry{{fieldErr.Field, typeTag(fieldErr.Type)}}, true
+	}
+
+	var decodeErrs form.DecodeErrors
+	if errors.As(err, &decodeErrs) {
+		entries := []bindErrorEntry{}
+		for ns, e := range decodeErrs {
+			entries = append(entries, bindErrorEntry{ns, formErrorTag(e)})
+		}
+		return entries, true
+	}
+
+	return nil, false
+}
+
+// translateBindError translates the binding error tag for the namespace.
+func translateBindError(trans ut.Translator, namespace, tag string) string {
+	field := namespace
+	if i := strings.LastIndex(field, "."); i >= 0 {
+		field = field[i+1:]
+	}
+
+	if msg, err := trans.T(tag, field); err == nil {
+		return msg
+	}
+
+	if msg, err := defaultTranslator().T(tag, field); err == nil {
+		return msg
+	}
+
+	return fmt.Sprintf("%s has an invalid value", field)
+}
+
+// FormatBindError formats errors returned by the binders into the same
+// structure FormatErrors produces. The second return value is false if
+// err is not a known binding error, i.e an I/O error.
+//
+// Known binding errors are UnknownFieldError, json.UnmarshalTypeError,
+// BindFieldError and form.DecodeErrors.
+//
+// Example output for a JSON body {"age":"12","addresses":[{"street":1}]} -
+//
+//	{
+//	  "age": "age must be an integer",
+//	  "addresses": [
+//	    {
+//	      "street": "street must be a string"
+//	    }
+//	  ]
+//	}
+func FormatBindError(err error) (any, bool) {
+	return FormatBindErrorContext(context.Background(), err)
+}
+
+// FormatBindErrorContext is like FormatBindError but translates the messages
+// to the locale carried by ctx. See WithLocale.
+func FormatBindErrorContext(ctx context.Context, err error) (any, bool) {
+	entries, ok := bindErrorEntries(err)
+	if !ok {
+		return nil, false
+	}
+
+	trans := translator(ctx)
+
+	rv := map[string]any{}
+	for _, e := range entries {
+		// prefix a root so that setValueInMap keeps the first key
+		setValueInMap(rv, "_."+e.namespace, translateBindError(trans, e.namespace, e.tag))
+	}
+
+	return unwrapRoot(rv), true
+}
+
+// FormatBindErrorDetailed is like FormatBindError but formats each error
+// as ErrorDetail. The code of binding errors is one of
+// unknown_field, bind_integer, bind_number, bind_boolean, bind_string,
+// bind_array, bind_object and bind_invalid.
+func FormatBindErrorDetailed(err error) (any, bool) {
+	return FormatBindErrorDetailedContext(context.Background(), err)
+}
+
+// FormatBindErrorDetailedContext is like FormatBindErrorDetailed but translates
+// the messages to the locale carried by ctx. See WithLocale.
+func FormatBindErrorDetailedContext(ctx context.Context, err error) (any, bool) {
+	entries, ok := bindErrorEntries(err)
+	if !ok {
+		return nil, false
+	}
+
+	trans := translator(ctx)
+
+	rv := map[string]any{}
+	for _, e := range entries {
+		setValueInMap(rv, "_."+e.namespace, ErrorDetail{
+			Code:    e.tag,
+			Message: translateBindError(trans, e.namespace, e.tag),
+		})
+	}
+
+	return unwrapRoot(rv), true
+}
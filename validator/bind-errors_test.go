@@ -0,0 +1,87 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBindError(t *testing.T) {
+	type address struct {
+		Street string `json:"street" form:"street"`
+	}
+
+	type dto struct {
+		Age       int       `json:"age"       form:"age"`
+		Active    bool      `json:"active"    form:"active"`
+		Addresses []address `json:"addresses" form:"addresses"`
+	}
+
+	body := func(s string) io.ReadCloser {
+		return io.NopCloser(strings.NewReader(s))
+	}
+
+	type testCase struct {
+		bind     func(*dto) error
+		expected any
+	}
+
+	testCases := []testCase{
+		{
+			func(d *dto) error {
+				return validator.BindJSON(context.Background(), body(`{"age":"12"}`), d)
+			},
+			map[string]any{"age": "age must be an integer"},
+		},
+		{
+			func(d *dto) error {
+				return validator.BindJSON(context.Background(), body(`{"addresses":[{"street":"a"},{"street":1}]}`), d)
+			},
+			map[string]any{"addresses": []any{nil, map[string]any{"street": "street must be a string"}}},
+		},
+		{
+			func(d *dto) error {
+				return validator.BindJSON(context.Background(), body(`{"addresses":{}}`), d)
+			},
+			map[string]any{"addresses": "addresses must be an array"},
+		},
+		{
+			func(d *dto) error {
+				return validator.BindUrlValues(context.Background(), url.Values{"age": {"abc"}, "active": {"maybe"}}, d)
+			},
+			map[string]any{"age": "age must be an integer", "active": "active must be a boolean"},
+		},
+	}
+
+	for _, c := range testCases {
+		var d dto
+		err := c.bind(&d)
+
+		rv, ok := validator.FormatBindError(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, c.expected, rv)
+	}
+
+	_, ok := validator.FormatBindError(errors.New("io error"))
+	assert.False(t, ok)
+}
+
+func TestBindJSONMalformed(t *testing.T) {
+	type dto struct {
+		Name string `json:"name"`
+	}
+
+	for _, payload := range []string{``, `{"name":`, `{"name" "Asif"}`, `[]`, `"Asif"`} {
+		var d dto
+		err := validator.BindJSON(context.Background(), io.NopCloser(strings.NewReader(payload)), &d)
+
+		assert.ErrorIs(t, err, validator.ErrMalformedJSON)
+	}
+}
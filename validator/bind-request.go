@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"context"
+	"mime"
+	"net/http"
+)
+
+// DefaultMaxMemory is the default maximum memory used to parse multipart
+// forms by the binders and the validation middlewares. Rest of the parts
+// are stored in temporary files.
+const DefaultMaxMemory = 100 << 20
+
+// RequestOptions configures request body decoding of BindRequestWithOptions.
+type RequestOptions struct {
+	// JSON is the JSON decoding options. Global JSON options set via
+	// SetJSONOptions are used if nil.
+	JSON *JSONOptions
+
+	// MaxMemory is the maximum bytes of multipart form parts kept in memory.
+	// Defaults to DefaultMaxMemory if not positive.
+	MaxMemory int64
+}
+
+// BindRequest binds data from every part of the request into a single
+// struct instance. Additionally it runs the struct through mold transformer
+// once all the data are bound.
+//
+// Data are bound in the following order, so that a later source
+// overrides an earlier one for the same field -
+//
+// 1. request body, via json tags for JSON body or via form tags for form data
+// and files
+// 2. search queries, via query tags
+// 3. path values, via path tags
+// 4. headers, via header tags
+// 5. cookies, via cookie tags
+//
+// Fields bound from search queries, headers and cookies may be slices
+// to receive all values of a key.
+//
+// Example DTO -
+//
+//	type UpdateUser struct {
+//		Id       int    `path:"id"             json:"-"`
+//		Notify   bool   `query:"notify"        json:"-"`
+//		TenantId string `header:"X-Tenant-ID"  json:"-"`
+//		Session  string `cookie:"session"      json:"-"`
+//		Name     string `json:"name"`
+//	}
+func BindRequest(ctx context.Context, r *http.Request, s any) error {
+	return BindRequestWithOptions(ctx, r, s, RequestOptions{})
+}
+
+// BindRequestWithOptions works like BindRequest, but decodes request body
+// using o instead of the global options.
+func BindRequestWithOptions(ctx context.Context, r *http.Request, s any, o RequestOptions) error {
+	if err := bindBody(r, s, o); err != nil {
+		return err
+	}
+
+	if err := bindTagValues(s, "query", func(key string) []string {
+		return r.URL.Query()[key]
+	}); err != nil {
+		return err
+	}
+
+	if err := bindTagValues(s, "path", pathValues(r)); err != nil {
+		return err
+	}
+
+	if err := bindTagValues(s, "header", r.Header.Values); err != nil {
+		return err
+	}
+
+	if err := bindTagValues(s, "cookie", cookieValues(r.Cookies())); err != nil {
+		return err
+	}
+
+	return runMold(ctx, s)
+}
+
+// bindBody decodes request body into s based on the content type.
+// Bodies of any other content type are ignored.
+func bindBody(r *http.Request, s any, o RequestOptions) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("content-type"))
+
+	switch contentType {
+	case "application/json":
+		defer r.Body.Close()
+		if o.JSON != nil {
+			return decodeJSON(r.Body, s, *o.JSON)
+		}
+		return decodeJSON(r.Body, s, jsonOptions)
+
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return err
+		}
+		return formDecoder.Decode(s, r.PostForm)
+
+	case "multipart/form-data":
+		maxMemory := o.MaxMemory
+		if maxMemory <= 0 {
+			maxMemory = DefaultMaxMemory
+		}
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			return err
+		}
+		if err := formDecoder.Decode(s, r.MultipartForm.Value); err != nil {
+			return err
+		}
+		bindFiles(s, r.MultipartForm.File)
+	}
+
+	return nil
+}
+
+// pathValues returns a lookup function for the request's path values.
+func pathValues(r *http.Request) func(string) []string {
+	return func(key string) []string {
+		if v := r.PathValue(key); len(v) > 0 {
+			return []string{v}
+		}
+		return nil
+	}
+}
+
+// cookieValues returns a lookup function for cookies.
+func cookieValues(cookies []*http.Cookie) func(string) []string {
+	return func(key string) []string {
+		values := []string{}
+		for _, c := range cookies {
+			if c.Name == key {
+				values = append(values, c.Value)
+			}
+		}
+		return values
+	}
+}
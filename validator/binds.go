@@ -2,13 +2,13 @@ package validator
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 // BindUrlValues binds url.Values into a struct instance.
@@ -21,11 +21,18 @@ func BindUrlValues(ctx context.Context, v url.Values, s any) error {
 	return runMold(ctx, s)
 }
 
-// BindJSON binds body into a struct instance.
+// BindJSON binds body into a struct instance using the global JSON options
+// set via SetJSONOptions.
 // Additionally it runs the struct through mold transformer.
 func BindJSON(ctx context.Context, body io.ReadCloser, s any) error {
+	return BindJSONWithOptions(ctx, body, s, jsonOptions)
+}
+
+// BindJSONWithOptions binds body into a struct instance using o.
+// Additionally it runs the struct through mold transformer.
+func BindJSONWithOptions(ctx context.Context, body io.ReadCloser, s any, o JSONOptions) error {
 	defer body.Close()
-	if err := json.NewDecoder(body).Decode(s); err != nil {
+	if err := decodeJSON(body, s, o); err != nil {
 		return err
 	}
 
@@ -35,12 +42,59 @@ func BindJSON(ctx context.Context, body io.ReadCloser, s any) error {
 // BindPathValues binds values from the URL path to the struct fields
 // based on the "path" tag. It uses reflection to dynamically set the field values.
 func BindPathValues(ctx context.Context, r *http.Request, s any) error {
+	if err := bindTagValues(s, "path", pathValues(r)); err != nil {
+		return err
+	}
+
+	return runMold(ctx, s)
+}
+
+// BindHeaders binds header values to the struct fields based on the
+// "header" tag. Header names are case insensitive. Slice fields receive
+// all the values of a header, including the comma separated ones of a
+// single line, other fields receive the first one.
+// Additionally it runs the struct through mold transformer.
+//
+// Example DTO -
+//
+//	type Headers struct {
+//		RequestId string   `header:"X-Request-ID" validate:"required,uuid"`
+//		IfMatch   []string `header:"If-Match"`
+//	}
+func BindHeaders(ctx context.Context, h http.Header, s any) error {
+	if err := bindTagValues(s, "header", h.Values); err != nil {
+		return err
+	}
+
+	return runMold(ctx, s)
+}
+
+// BindCookies binds cookie values to the struct fields based on the
+// "cookie" tag. Slice fields receive the values of all cookies with the
+// same name, other fields receive the first one.
+// Additionally it runs the struct through mold transformer.
+func BindCookies(ctx context.Context, cookies []*http.Cookie, s any) error {
+	if err := bindTagValues(s, "cookie", cookieValues(cookies)); err != nil {
+		return err
+	}
+
+	return runMold(ctx, s)
+}
+
+// bindTagValues sets the fields of struct s having tag with the values
+// returned by lookup for the tag value. Slice fields receive all the
+// values, other fields receive the first one.
+func bindTagValues(s any, tag string, lookup func(string) []string) error {
 	val := reflect.ValueOf(s)
 	// If s is a pointer, dereference it to access the actual struct.
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
 
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
 	typ := val.Type()
 
 	for i := 0; i < val.NumField(); i++ {
@@ -53,27 +107,77 @@ func BindPathValues(ctx context.Context, r *http.Request, s any) error {
 
 		structField := typ.Field(i)
 
-		pathTag := structField.Tag.Get("path")
+		key := structField.Tag.Get(tag)
 
-		// Skip fields that don't have a "path" tag.
-		if pathTag == "" {
+		// Skip fields that don't have the tag.
+		if key == "" {
 			continue
 		}
 
-		pathValue := r.PathValue(pathTag)
+		values := lookup(key)
+
+		// Header lists may be sent on a single line separated by commas.
+		if tag == "header" && field.Kind() == reflect.Slice {
+			values = splitHeaderList(values)
+		}
 
-		// If the path parameter is empty, skip this field.
-		if pathValue == "" {
+		// If there's no value for the key, skip this field.
+		if len(values) == 0 {
 			continue
 		}
 
-		// Set the field value using the path value.
-		if err := setFieldValue(field, pathValue); err != nil {
-			return fmt.Errorf("failed to set field %s: %w", structField.Name, err)
+		// Set the field value using the found values.
+		if err := setFieldValues(field, values); err != nil {
+			typ := field.Type()
+			if typ.Kind() == reflect.Slice {
+				typ = typ.Elem()
+			}
+
+			return &BindFieldError{
+				Field: key,
+				Type:  typ,
+				Err:   err,
+			}
 		}
 	}
 
-	return runMold(ctx, s)
+	return nil
+}
+
+// splitHeaderList splits comma separated header values into their elements,
+// leaving commas inside quoted strings intact. Elements are trimmed and
+// empty ones are dropped.
+func splitHeaderList(values []string) []string {
+	rv := []string{}
+
+	appendElement := func(e string) {
+		if e = strings.TrimSpace(e); len(e) > 0 {
+			rv = append(rv, e)
+		}
+	}
+
+	for _, v := range values {
+		quoted, escaped := false, false
+		start := 0
+
+		for i := 0; i < len(v); i++ {
+			switch c := v[i]; {
+			case escaped:
+				escaped = false
+			case quoted && c == '\\':
+				escaped = true
+			case c == '"':
+				quoted = !quoted
+			case c == ',' && !quoted:
+				appendElement(v[start:i])
+				start = i + 1
+			}
+		}
+
+		appendElement(v[start:])
+	}
+
+	return rv
 }
 
 // runMold applies mold transformations to struct values, recursing into slices if needed
@@ -115,10 +219,34 @@ func runMold(ctx context.Context, s any) error {
 	}
 }
 
+// setFieldValues sets the value of a struct field based on the string values.
+// Slice fields are set with all the values, other fields with the first one.
+func setFieldValues(field reflect.Value, values []string) error {
+	if field.Kind() != reflect.Slice {
+		return setFieldValue(field, values[0])
+	}
+
+	slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+	for i, v := range values {
+		if err := setFieldValue(slice.Index(i), v); err != nil {
+			return err
+		}
+	}
+	field.Set(slice)
+
+	return nil
+}
+
 // setFieldValue sets the value of a struct field based on the string value.
 // It converts the string value to the appropriate type based on the field's type.
 func setFieldValue(field reflect.Value, value string) error {
 	switch field.Kind() {
+	case reflect.Pointer:
+		v := reflect.New(field.Type().Elem())
+		if err := setFieldValue(v.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(v)
 	case reflect.String:
 		field.SetString(value)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
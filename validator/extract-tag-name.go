@@ -11,10 +11,13 @@ import (
 //
 // 1. form tag
 // 2. path tag
-// 3. json tag
-// 4. struct field name
+// 3. query tag
+// 4. header tag
+// 5. cookie tag
+// 6. json tag
+// 7. struct field name
 func ExtractTagName(fld reflect.StructField) string {
-	for _, tag := range []string{"form", "path", "json"} {
+	for _, tag := range []string{"form", "path", "query", "header", "cookie", "json"} {
 		tagName := strings.SplitN(fld.Tag.Get(tag), ",", 2)[0]
 		if len(tagName) > 0 {
 			return tagName
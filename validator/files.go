@@ -0,0 +1,220 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	ut "github.com/go-playground/universal-translator"
+	vd "github.com/go-playground/validator/v10"
+)
+
+var (
+	fileHeaderType      = reflect.TypeOf(&multipart.FileHeader{})
+	fileHeaderSliceType = reflect.TypeOf([]*multipart.FileHeader{})
+
+	// fileSizes caches the parsed maxfilesize params, invalid ones as -1.
+	fileSizes sync.Map
+)
+
+// BindMultipartForm binds multipart form values and files into a struct
+// instance via form tags. Files are bound into fields of type
+// *multipart.FileHeader or []*multipart.FileHeader.
+// Additionally it runs the struct through mold transformer.
+//
+// Example DTO -
+//
+//	type Upload struct {
+//		Title  string                  `form:"title"  validate:"required"`
+//		Avatar *multipart.FileHeader   `form:"avatar" validate:"required,maxfilesize=1MB,mimetypes=image/png image/jpeg"`
+//		Photos []*multipart.FileHeader `form:"photos" validate:"max=5,dive,mimetypes=image/*"`
+//	}
+func BindMultipartForm(ctx context.Context, form *multipart.Form, s any) error {
+	if err := formDecoder.Decode(s, form.Value); err != nil {
+		return err
+	}
+
+	bindFiles(s, form.File)
+
+	return runMold(ctx, s)
+}
+
+// bindFiles sets the file fields of struct s having form tag
+// with the files found for the tag value.
+func bindFiles(s any, files map[string][]*multipart.FileHeader) {
+	val := reflect.ValueOf(s)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return
+	}
+
+	typ := val.Type()
+
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		key := strings.SplitN(typ.Field(i).Tag.Get("form"), ",", 2)[0]
+		if key == "" || len(files[key]) == 0 {
+			continue
+		}
+
+		switch field.Type() {
+		case fileHeaderType:
+			field.Set(reflect.ValueOf(files[key][0]))
+		case fileHeaderSliceType:
+			field.Set(reflect.ValueOf(files[key]))
+		}
+	}
+}
+
+// fileHeader returns the file header of the field being validated.
+func fileHeader(fl vd.FieldLevel) (*multipart.FileHeader, bool) {
+	fh, ok := fl.Field().Interface().(multipart.FileHeader)
+	return &fh, ok
+}
+
+// parseFileSize parses sizes like 512, 100KB, 10MB or 1GB into bytes.
+func parseFileSize(param string) (int64, error) {
+	units := []struct {
+		suffix string
+		size   int64
+	}{
+		{"GB", 1 << 30},
+		{"MB", 1 << 20},
+		{"KB", 1 << 10},
+		{"B", 1},
+	}
+
+	param = strings.ToUpper(strings.TrimSpace(param))
+	for _, u := range units {
+		if n, ok := strings.CutSuffix(param, u.suffix); ok {
+			size, err := strconv.ParseInt(strings.TrimSpace(n), 10, 64)
+			return size * u.size, err
+		}
+	}
+
+	return strconv.ParseInt(param, 10, 64)
+}
+
+// maxFileSizeParam returns the parsed maxfilesize param. Invalid params are
+// logged once and reported as not ok.
+func maxFileSizeParam(param string) (int64, bool) {
+	if size, ok := fileSizes.Load(param); ok {
+		return size.(int64), size.(int64) >= 0
+	}
+
+	size, err := parseFileSize(param)
+	if err == nil && size < 0 {
+		err = fmt.Errorf("negative size")
+	}
+	if err != nil {
+		slog.Error(fmt.Sprintf("invalid maxfilesize param %q: %s", param, err.Error()))
+		size = -1
+	}
+
+	fileSizes.Store(param, size)
+	return size, size >= 0
+}
+
+// maxFileSize validates that the file is not larger than the param.
+// Validation fails if the param is invalid.
+func maxFileSize(fl vd.FieldLevel) bool {
+	fh, ok := fileHeader(fl)
+	if !ok {
+		return false
+	}
+
+	size, ok := maxFileSizeParam(fl.Param())
+	if !ok {
+		return false
+	}
+
+	return fh.Size <= size
+}
+
+// sniffMimeType detects the media type of the file content.
+func sniffMimeType(fh *multipart.FileHeader) (string, error) {
+	f, err := fh.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+	return mimeType, err
+}
+
+// mimeTypes validates that the sniffed media type of the file content is
+// one of the space separated media types in the param. Wildcard subtypes
+// like image/* are supported.
+func mimeTypes(fl vd.FieldLevel) bool {
+	fh, ok := fileHeader(fl)
+	if !ok {
+		return false
+	}
+
+	mimeType, err := sniffMimeType(fh)
+	if err != nil {
+		return false
+	}
+
+	for _, allowed := range strings.Fields(fl.Param()) {
+		if allowed == mimeType {
+			return true
+		}
+
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok &&
+			strings.HasPrefix(mimeType, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// translateWithParam translates FieldError using it's param.
+func translateWithParam(ut ut.Translator, fe vd.FieldError) string {
+	t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+	if err != nil {
+		return translateFunc(ut, fe)
+	}
+
+	return t
+}
+
+// registerFileValidators registers validators and translations for files.
+func registerFileValidators() {
+	RegisterValidator("maxfilesize", maxFileSize)
+	RegisterValidator("mimetypes", mimeTypes)
+
+	RegisterTranslation(Translation{
+		Tag:             "maxfilesize",
+		Translation:     "{0} must not be larger than {1}",
+		CustomTransFunc: translateWithParam,
+	})
+	RegisterTranslation(Translation{
+		Tag:             "mimetypes",
+		Translation:     "{0} must be one of the following file types [{1}]",
+		CustomTransFunc: translateWithParam,
+	})
+}
@@ -0,0 +1,33 @@
+package validator_test
+
+import (
+	"context"
+	"mime/multipart"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/validator"
+	vd "github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxFileSizeInvalidParam(t *testing.T) {
+	type dto struct {
+		Valid   *multipart.FileHeader `validate:"maxfilesize=1KB"`
+		Invalid *multipart.FileHeader `validate:"maxfilesize=one KB"`
+	}
+
+	d := dto{
+		Valid:   &multipart.FileHeader{Size: 10},
+		Invalid: &multipart.FileHeader{Size: 10},
+	}
+
+	for range 2 {
+		assert.NotPanics(t, func() {
+			err := validator.ValidateStruct(context.Background(), &d)
+
+			assert.Equal(t, map[string]any{
+				"Invalid": "Invalid must not be larger than one KB",
+			}, validator.FormatErrors(err.(vd.ValidationErrors)))
+		})
+	}
+}
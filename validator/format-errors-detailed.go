@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"context"
+
+	vd "github.com/go-playground/validator/v10"
+)
+
+// ErrorDetail is the machine readable form of a field's validation error.
+type ErrorDetail struct {
+	// Code is the validator's tag, i.e "required" or "email".
+	// Clients can use it as a stable key for their own translations.
+	Code string `json:"code"`
+
+	// Param is the tag's parameter, i.e "3" for "min=3".
+	Param string `json:"param"`
+
+	// Message is the translated error message.
+	Message string `json:"message"`
+}
+
+// FormatErrorsDetailed is like FormatErrors but formats each field's error
+// as ErrorDetail, keeping the same nested structure.
+//
+// Example validation error output -
+//
+//	{
+//	  "email": {
+//	    "code": "email",
+//	    "param": "",
+//	    "message": "email must be a valid email address"
+//	  },
+//	  "books": [
+//	    {
+//	      "code": "min",
+//	      "param": "10",
+//	      "message": "books[0] must be at least 10 characters in length"
+//	    }
+//	  ]
+//	}
+func FormatErrorsDetailed(err vd.ValidationErrors) any {
+	return FormatErrorsDetailedContext(context.Background(), err)
+}
+
+// FormatErrorsDetailedContext is like FormatErrorsDetailed but translates
+// the messages to the locale carried by ctx. See WithLocale.
+func FormatErrorsDetailedContext(ctx context.Context, err vd.ValidationErrors) any {
+	rv := map[string]any{}
+
+	for _, e := range err {
+		setValueInMap(rv, e.Namespace(), ErrorDetail{
+			Code:    e.Tag(),
+			Param:   e.Param(),
+			Message: translateFieldError(ctx, e),
+		})
+	}
+
+	return unwrapRoot(rv)
+}
@@ -1,13 +1,14 @@
 package validator
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
 	vd "github.com/go-playground/validator/v10"
 )
 
-func setValueInMap(m map[string]any, key string, value string) {
+func setValueInMap(m map[string]any, key string, value any) {
 	keys := strings.Split(key, ".")
 	currMap := m
 
@@ -47,7 +48,7 @@ func handleArrayKey(
 	currMap map[string]any,
 	key string,
 	last bool,
-	value string,
+	value any,
 ) map[string]any {
 	parts := strings.Split(key, "[")
 	mapKey := parts[0]
@@ -75,7 +76,7 @@ func handleMapKey(
 	currMap map[string]any,
 	key string,
 	last bool,
-	value string,
+	value any,
 ) map[string]any {
 	if currMap[key] == nil {
 		if last {
@@ -139,17 +140,38 @@ func handleMapKey(
 //	  }
 //	]
 func FormatErrors(err vd.ValidationErrors) any {
-	trans, _ := uni.GetTranslator("en")
+	return FormatErrorsContext(context.Background(), err)
+}
 
+// FormatErrorsContext is like FormatErrors but translates the messages
+// to the locale carried by ctx. See WithLocale.
+func FormatErrorsContext(ctx context.Context, err vd.ValidationErrors) any {
 	rv := map[string]any{}
 
 	for _, e := range err {
-		setValueInMap(rv, e.Namespace(), e.Translate(trans))
+		setValueInMap(rv, e.Namespace(), translateFieldError(ctx, e))
+	}
+
+	return unwrapRoot(rv)
+}
+
+// translateFieldError translates e to the locale carried by ctx.
+func translateFieldError(ctx context.Context, e vd.FieldError) string {
+	msg := e.Translate(translator(ctx))
+	// untranslated errors are returned as is, fall back to english
+	if msg == e.Error() {
+		msg = e.Translate(defaultTranslator())
 	}
 
-	// If the entire payload was a root slice, our parsed map will only
-	// have a single empty string key `""`. In this case, we extract the slice
-	// and return it directly so the error structure perfectly matches the input DTO.
+	return msg
+}
+
+// unwrapRoot returns the root slice of rv if the payload was a slice.
+//
+// If the entire payload was a root slice, our parsed map will only
+// have a single empty string key `""`. In this case, we extract the slice
+// and return it directly so the error structure perfectly matches the input DTO.
+func unwrapRoot(rv map[string]any) any {
 	if len(rv) == 1 {
 		if val, ok := rv[""]; ok {
 			return val
@@ -97,3 +97,39 @@ func TestFormatErrors_SlicePayload(t *testing.T) {
 	assert.NotNil(t, errMsg)
 	assert.Equal(t, expectedMsg, errMsg)
 }
+
+func TestFormatErrorsDetailed(t *testing.T) {
+	type book struct {
+		Title string `json:"title" validate:"min=3"`
+	}
+
+	type dto struct {
+		Email string `json:"email" validate:"required,email"`
+		Books []book `json:"books" validate:"dive"`
+	}
+
+	err := validator.ValidateStruct(context.Background(), &dto{
+		Email: "asif",
+		Books: []book{{"Go in Action"}, {"Go"}},
+	})
+
+	expected := map[string]any{
+		"email": validator.ErrorDetail{
+			Code:    "email",
+			Param:   "",
+			Message: "email must be a valid email address",
+		},
+		"books": []any{
+			nil,
+			map[string]any{
+				"title": validator.ErrorDetail{
+					Code:    "min",
+					Param:   "3",
+					Message: "title must be at least 3 characters in length",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, validator.FormatErrorsDetailed(err.(vd.ValidationErrors)))
+}
@@ -26,7 +26,7 @@ func init() {
 
 	// setup validator's translations
 	validate = vd.New()
-	et, _ := uni.GetTranslator("en")
+	et, _ := uni.GetTranslator(DefaultLocale)
 	ve.RegisterDefaultTranslations(validate, et)
 
 	// setup form (url.Values) decoder
@@ -38,4 +38,10 @@ func init() {
 
 	// setup field name extractor
 	validate.RegisterTagNameFunc(ExtractTagName)
+
+	// setup file validators
+	registerFileValidators()
+
+	// setup binding error translations
+	registerBindTranslations(et)
 }
@@ -0,0 +1,126 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// JSONOptions configures JSON decoding of the JSON binders.
+type JSONOptions struct {
+	// DisallowUnknownFields rejects objects having keys that do not match
+	// any field of the destination struct.
+	DisallowUnknownFields bool
+
+	// DisallowTrailingData rejects anything but whitespace after the
+	// first JSON value in the body.
+	DisallowTrailingData bool
+
+	// UseNumber decodes numbers into json.Number instead of float64
+	// for fields of interface type.
+	UseNumber bool
+}
+
+// StrictJSON rejects unknown fields and trailing data.
+var StrictJSON = JSONOptions{
+	DisallowUnknownFields: true,
+	DisallowTrailingData:  true,
+}
+
+// jsonOptions are the global JSON options used by BindJSON and BindRequest.
+var jsonOptions JSONOptions
+
+// SetJSONOptions sets the global JSON options used by BindJSON and BindRequest.
+// Application should setup this once in it's lifetime.
+func SetJSONOptions(o JSONOptions) {
+	jsonOptions = o
+}
+
+// ErrTrailingData is returned when there's data after the first JSON value
+// while JSONOptions.DisallowTrailingData is set.
+var ErrTrailingData = errors.New("json: unexpected data after top-level value")
+
+// UnknownFieldError is returned when a JSON object has a key not matching
+// any field while JSONOptions.DisallowUnknownFields is set.
+type UnknownFieldError struct {
+	// Field is the unknown key.
+	Field string
+}
+
+// Error implements error.
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("json: unknown field %q", e.Field)
+}
+
+// unknownFieldPrefix is the prefix of encoding/json's error message for
+// unknown fields.
+const unknownFieldPrefix = "json: unknown field "
+
+// decodeJSON decodes JSON from body into s using o.
+func decodeJSON(body io.Reader, s any, o JSONOptions) error {
+	dec := json.NewDecoder(body)
+
+	if o.DisallowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+
+	if o.UseNumber {
+		dec.UseNumber()
+	}
+
+	if err := dec.Decode(s); err != nil {
+		// encoding/json doesn't export a type for unknown field errors,
+		// TestUnknownFieldErrorText pins the message matched here
+		if field, ok := strings.CutPrefix(err.Error(), unknownFieldPrefix); ok {
+			if f, e := strconv.Unquote(field); e == nil {
+				return &UnknownFieldError{Field: f}
+			}
+		}
+
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("%w: %w", ErrMalformedJSON, err)
+		}
+
+		// type mismatch of the whole body, i.e an array for a struct
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && len(typeErr.Field) == 0 {
+			return fmt.Errorf("%w: %w", ErrMalformedJSON, err)
+		}
+
+		// type mismatch of a field, read errors like *http.MaxBytesError etc.
+		return err
+	}
+
+	if o.DisallowTrailingData {
+		_, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+
+		var syntaxErr *json.SyntaxError
+		if err == nil || errors.As(err, &syntaxErr) {
+			return fmt.Errorf("%w: %w", ErrMalformedJSON, ErrTrailingData)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// FormatUnknownField formats UnknownFieldError into a map keyed by the
+// unknown field, in the same structure FormatErrors produces.
+//
+// Example output -
+//
+//	{
+//	  "nmae": "nmae is not a valid field"
+//	}
+func FormatUnknownField(err *UnknownFieldError) any {
+	rv, _ := FormatBindError(err)
+	return rv
+}
@@ -0,0 +1,89 @@
+package validator_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/asif-mahmud/go-httputil/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindJSONWithGlobalOptions(t *testing.T) {
+	type dto struct {
+		Name  string `json:"name"`
+		Extra any    `json:"extra"`
+	}
+
+	body := func(s string) io.ReadCloser {
+		return io.NopCloser(strings.NewReader(s))
+	}
+
+	validator.SetJSONOptions(validator.JSONOptions{
+		DisallowUnknownFields: true,
+		UseNumber:             true,
+	})
+	defer validator.SetJSONOptions(validator.JSONOptions{})
+
+	var d dto
+	err := validator.BindJSON(context.Background(), body(`{"name":"Asif","extra":12}`), &d)
+
+	assert.Nil(t, err)
+	assert.Equal(t, json.Number("12"), d.Extra)
+
+	err = validator.BindJSON(context.Background(), body(`{"name":"Asif","age":12}`), &d)
+
+	assert.Equal(t, &validator.UnknownFieldError{Field: "age"}, err)
+	assert.Equal(t, map[string]any{"age": "age is not a valid field"}, validator.FormatUnknownField(err.(*validator.UnknownFieldError)))
+
+	// per call options override the global ones
+	err = validator.BindJSONWithOptions(
+		context.Background(),
+		body(`{"name":"Asif"} []`),
+		&d,
+		validator.StrictJSON,
+	)
+
+	assert.ErrorIs(t, err, validator.ErrTrailingData)
+}
+
+// decodeJSON detects unknown fields by encoding/json's error message,
+// this fails if the message changes.
+func TestUnknownFieldErrorText(t *testing.T) {
+	type dto struct {
+		Name string `json:"name"`
+	}
+
+	var d dto
+	dec := json.NewDecoder(strings.NewReader(`{"name":"Asif","nmae":"Asif"}`))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(&d)
+
+	assert.EqualError(t, err, `json: unknown field "nmae"`)
+}
+
+func TestBindJSONReadError(t *testing.T) {
+	type dto struct {
+		Name string `json:"name"`
+	}
+
+	readErr := errors.New("read error")
+
+	var d dto
+	err := validator.BindJSON(context.Background(), io.NopCloser(iotest.ErrReader(readErr)), &d)
+
+	assert.NotErrorIs(t, err, validator.ErrMalformedJSON)
+	assert.ErrorIs(t, err, readErr)
+
+	// read errors after the JSON value are not trailing data
+	body := io.MultiReader(strings.NewReader(`{"name":"Asif"}`), iotest.ErrReader(readErr))
+	err = validator.BindJSONWithOptions(context.Background(), io.NopCloser(body), &d, validator.StrictJSON)
+
+	assert.NotErrorIs(t, err, validator.ErrTrailingData)
+	assert.ErrorIs(t, err, readErr)
+}
@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+	vd "github.com/go-playground/validator/v10"
+)
+
+// DefaultLocale is the locale used when no other locale is selected.
+const DefaultLocale = "en"
+
+// DefaultTranslationsFunc registers default translations of a locale.
+// The bundled translations of validator, i.e
+// github.com/go-playground/validator/v10/translations/fr.RegisterDefaultTranslations
+// match this signature.
+type DefaultTranslationsFunc func(*vd.Validate, ut.Translator) error
+
+type localeCtxKey struct{}
+
+// RegisterLocale adds a locale to the universal translator and registers
+// it's default translations. defaults can be nil if there's no bundled
+// translations for the locale, i.e Bengali. In that case translations
+// should be registered using RegisterTranslation with Translation.Locale set.
+//
+// Messages missing in a locale fall back to their english translations.
+// Registering an already registered locale is a no-op.
+//
+// Example -
+//
+//	import (
+//		"github.com/go-playground/locales/fr"
+//		frtrans "github.com/go-playground/validator/v10/translations/fr"
+//	)
+//
+//	validator.RegisterLocale(fr.New(), frtrans.RegisterDefaultTranslations)
+func RegisterLocale(l locales.Translator, defaults DefaultTranslationsFunc) error {
+	if _, found := uni.GetTranslator(l.Locale()); found {
+		return nil
+	}
+
+	if err := uni.AddTranslator(l, false); err != nil {
+		return err
+	}
+
+	if defaults == nil {
+		return nil
+	}
+
+	trans, _ := uni.GetTranslator(l.Locale())
+	if err := defaults(validate, trans); err != nil {
+		return fmt.Errorf("failed to register translations of %s: %w", l.Locale(), err)
+	}
+
+	return nil
+}
+
+// WithLocale returns a copy of ctx carrying the locale used to
+// translate validation messages.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeCtxKey{}, locale)
+}
+
+// Locale returns the locale carried by ctx or DefaultLocale.
+func Locale(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeCtxKey{}).(string); ok && len(locale) > 0 {
+		return locale
+	}
+
+	return DefaultLocale
+}
+
+// RequestLocale returns the locale for the request. A locale set on the
+// request context by WithLocale takes precedence over the Accept-Language
+// header. It returns DefaultLocale if none of the accepted languages
+// is registered.
+func RequestLocale(r *http.Request) string {
+	if locale, ok := r.Context().Value(localeCtxKey{}).(string); ok && len(locale) > 0 {
+		return locale
+	}
+
+	return AcceptLanguage(r.Header.Get("Accept-Language"))
+}
+
+// AcceptLanguage returns the registered locale best matching the
+// Accept-Language header value or DefaultLocale.
+//
+// Example, "fr-CH, fr;q=0.9, en;q=0.8" returns "fr" if the french locale
+// is registered.
+func AcceptLanguage(header string) string {
+	for _, tag := range parseAcceptLanguage(header) {
+		for _, locale := range localeCandidates(tag) {
+			if _, found := uni.GetTranslator(locale); found {
+				return locale
+			}
+		}
+	}
+
+	return DefaultLocale
+}
+
+// parseAcceptLanguage returns the language tags of the header
+// ordered by their quality values.
+func parseAcceptLanguage(header string) []string {
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	languages := []language{}
+	for part := range strings.SplitSeq(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if len(tag) == 0 || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			v, err := strconv.ParseFloat(q, 64)
+			if err != nil || v <= 0 {
+				continue
+			}
+			quality = v
+		}
+
+		languages = append(languages, language{tag, quality})
+	}
+
+	slices.SortStableFunc(languages, func(a, b language) int {
+		switch {
+		case a.quality > b.quality:
+			return -1
+		case a.quality < b.quality:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	tags := make([]string, len(languages))
+	for i, l := range languages {
+		tags[i] = l.tag
+	}
+
+	return tags
+}
+
+// localeCandidates converts a language tag like "pt-br" into the locale
+// names "pt_BR" and "pt".
+func localeCandidates(tag string) []string {
+	lang, region, ok := strings.Cut(strings.ReplaceAll(tag, "-", "_"), "_")
+	lang = strings.ToLower(lang)
+	if !ok {
+		return []string{lang}
+	}
+
+	return []string{lang + "_" + strings.ToUpper(region), lang}
+}
+
+// translator returns the translator of the locale carried by ctx.
+func translator(ctx context.Context) ut.Translator {
+	trans, _ := uni.GetTranslator(Locale(ctx))
+	return trans
+}
+
+// defaultTranslator returns the translator of DefaultLocale.
+func defaultTranslator() ut.Translator {
+	trans, _ := uni.GetTranslator(DefaultLocale)
+	return trans
+}
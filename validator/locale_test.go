@@ -0,0 +1,68 @@
+package validator_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asif-mahmud/go-httputil/validator"
+	"github.com/go-playground/locales/bn"
+	"github.com/go-playground/locales/de"
+	"github.com/go-playground/locales/fr"
+	vd "github.com/go-playground/validator/v10"
+	detrans "github.com/go-playground/validator/v10/translations/de"
+	frtrans "github.com/go-playground/validator/v10/translations/fr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocales(t *testing.T) {
+	assert.Nil(t, validator.RegisterLocale(fr.New(), frtrans.RegisterDefaultTranslations))
+	assert.Nil(t, validator.RegisterLocale(de.New(), detrans.RegisterDefaultTranslations))
+	assert.Nil(t, validator.RegisterLocale(bn.New(), nil))
+	assert.Nil(t, validator.RegisterLocale(fr.New(), nil))
+
+	validator.RegisterTranslation(validator.Translation{
+		Tag:         "required",
+		Translation: "{0} একটি আবশ্যক ক্ষেত্র",
+		Locale:      "bn",
+	})
+
+	type dto struct {
+		Name string `json:"name" validate:"required"`
+		Age  int    `json:"age"  validate:"gt=0"`
+	}
+
+	type testCase struct {
+		acceptLanguage string
+		expectedLocale string
+		expected       any
+	}
+
+	testCases := []testCase{
+		{"", "en", map[string]any{"name": "name is a required field", "age": "age must be greater than 0"}},
+		{"fr-CH, fr;q=0.9, en;q=0.8", "fr", map[string]any{"name": "name est un champ obligatoire", "age": "age doit être supérieur à 0"}},
+		{"es, de;q=0.5", "de", map[string]any{"name": "name ist ein Pflichtfeld", "age": "age muss größer als 0 sein"}},
+		{"en;q=0.1, bn-BD", "bn", map[string]any{"name": "name একটি আবশ্যক ক্ষেত্র", "age": "age must be greater than 0"}},
+		{"es, ja", "en", map[string]any{"name": "name is a required field", "age": "age must be greater than 0"}},
+	}
+
+	for _, c := range testCases {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("Accept-Language", c.acceptLanguage)
+
+		locale := validator.RequestLocale(r)
+		assert.Equal(t, c.expectedLocale, locale)
+
+		ctx := validator.WithLocale(context.Background(), locale)
+		err := validator.ValidateStruct(ctx, &dto{})
+
+		assert.Equal(t, c.expected, validator.FormatErrorsContext(ctx, err.(vd.ValidationErrors)))
+	}
+
+	// context value takes precedence over the header
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept-Language", "fr")
+	r = r.WithContext(validator.WithLocale(r.Context(), "de"))
+
+	assert.Equal(t, "de", validator.RequestLocale(r))
+}
@@ -44,6 +44,11 @@ type Translation struct {
 	// Override will override any existing translation for the tag.
 	Override bool
 
+	// Locale is the locale of the translation, i.e "fr".
+	// It defaults to DefaultLocale. The locale must be registered
+	// using RegisterLocale beforehand.
+	Locale string
+
 	// CustomRegisFunc custom registration function for validate.
 	//
 	// See the implementations in here -
@@ -70,9 +75,28 @@ type Translation struct {
 //		Translation: "{0} is a required field",
 //		Override:    false,
 //	}
+//
+// Translations for other locales can be registered by setting Translation.Locale -
+//
+//	{
+//		Tag:         "required",
+//		Translation: "{0} est un champ obligatoire",
+//		Override:    true,
+//		Locale:      "fr",
+//	}
 func RegisterTranslation(t Translation) {
+	locale := t.Locale
+	if len(locale) == 0 {
+		locale = DefaultLocale
+	}
+
+	trans, found := uni.GetTranslator(locale)
+	if !found {
+		slog.Warn(fmt.Sprintf("error registerring new translation: unknown locale %s", locale))
+		return
+	}
+
 	var err error
-	trans, _ := uni.GetTranslator("en")
 	if t.CustomTransFunc != nil && t.CustomRegisFunc != nil {
 		err = validate.RegisterTranslation(t.Tag, trans, t.CustomRegisFunc, t.CustomTransFunc)
 	} else if t.CustomTransFunc != nil && t.CustomRegisFunc == nil {